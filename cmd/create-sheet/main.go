@@ -15,6 +15,10 @@ func main() {
 	// 기본: 진단 모드
 	config.LoadEnv()
 	fmt.Println("[진단] Focus Time Tracker & Analyzer - Google Sheets 진단 모드")
+	if err := config.LoadCategories(); err != nil {
+		fmt.Println("[에러] 카테고리 설정 로드 실패:", err)
+		os.Exit(1)
+	}
 
 	// 1. 환경변수 체크
 	creds := config.Envs.GSheetsCredentialsJSON
@@ -46,9 +50,14 @@ func main() {
 	fmt.Println("[OK] Google Sheets API 인증 성공")
 
 	// 4. 파일 생성 시도
+	layout, err := config.SlotLayout()
+	if err != nil {
+		fmt.Println("[에러] SLOT_MINUTES 설정 오류:", err)
+		os.Exit(1)
+	}
 	title := "진단용 테스트시트"
 	year := time.Now().Year()
-	spreadsheetID, err := sheets.CreateYearlySheet(sheetsSrv, driveSrv, title, year)
+	spreadsheetID, err := sheets.CreateYearlySheet(sheetsSrv, driveSrv, title, year, layout)
 	if err != nil {
 		fmt.Println("[에러] Google Sheets 파일 생성 실패:", err)
 		os.Exit(1)
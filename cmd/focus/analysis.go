@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/config"
+	"github.com/crispy/focus-time-tracker/internal/exporter"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"github.com/crispy/focus-time-tracker/internal/sheets"
+	drivev3 "google.golang.org/api/drive/v3"
+	sheetsv4 "google.golang.org/api/sheets/v4"
+)
+
+// writeAnalysis: 추출한 날이 속한 연도 스프레드시트의 분석 탭에 전체 기록의 추세/이상치와 최신 그래프를 씀
+// - 쓰기 실패는 추출 결과에 영향을 주지 않도록 로그만 남김
+func writeAnalysis(ctx context.Context, sheetsSrv *sheetsv4.Service, driveSrv *drivev3.Service, m *exporter.Manifest) {
+	n, err := analysisWriteBack(ctx, sheetsSrv, driveSrv, m)
+	if err != nil {
+		log.Print(i18n.T("cli.err.analysis", err))
+		return
+	}
+	fmt.Println(i18n.T("cli.analysisDone", sheets.AnalysisSheetTitle, n))
+}
+
+// analysisWriteBack: manifest 날짜의 스프레드시트를 찾아 분석 탭 갱신
+// 반환: 표에 쓴 일수, 에러
+func analysisWriteBack(ctx context.Context, sheetsSrv *sheetsv4.Service, driveSrv *drivev3.Service, m *exporter.Manifest) (int, error) {
+	date, err := time.Parse("2006-01-02", m.Date)
+	if err != nil {
+		return 0, err
+	}
+	spreadsheetID, err := sheets.FindSpreadsheetIDByYear(ctx, driveSrv, config.Envs.GSheetsParentFolderID, date.Year())
+	if err != nil {
+		return 0, err
+	}
+	data, err := exporter.LoadAllFocusData(filepath.Join("dailydata", "raw"))
+	if err != nil {
+		return 0, err
+	}
+	imageURL := config.Envs.AnalysisImageURL
+	if imageURL == "" {
+		imageURL = config.Envs.NotifyImageURL
+	}
+	opts := sheets.AnalysisOptions{
+		Trend:    analyzer.TrendOptions{Window: config.Envs.AnalysisWindow},
+		ImageURL: imageURL,
+		Printer:  i18n.Default(),
+	}
+	// 갱신 시각은 Extract와 같이 한국 시간으로 표시 (러너 시간대와 무관)
+	loc, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		return 0, fmt.Errorf("Asia/Seoul 타임존 로드 실패: %w", err)
+	}
+	return sheets.WriteAnalysis(sheetsSrv, spreadsheetID, data, opts, time.Now().In(loc))
+}
@@ -2,68 +2,314 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
 	"github.com/crispy/focus-time-tracker/internal/config"
 	"github.com/crispy/focus-time-tracker/internal/exporter"
+	"github.com/crispy/focus-time-tracker/internal/github"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
 	"github.com/crispy/focus-time-tracker/internal/sheets"
+	"github.com/crispy/focus-time-tracker/internal/site"
+	"gonum.org/v1/plot/vg"
 )
 
 func main() {
 	config.LoadEnv()
+	analyzer.SetKoreanFontPath(config.Envs.KoreanFontPath)
 
-	if len(os.Args) > 1 {
-		switch os.Args[1] {
+	// 서브커맨드 앞의 전역 플래그 (--lang이 LOCALE 환경변수보다 우선)
+	// 플래그 설명/에러 문구도 같은 언어로 나오도록 flag 정의 전에 언어부터 설정
+	locale, err := i18n.LocaleFromArgs(os.Args[1:], config.Envs.Locale)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.locale", err))
+	}
+	i18n.SetDefault(locale)
+	if err := config.LoadCategories(); err != nil {
+		log.Fatal(i18n.T("cli.err.categories", err))
+	}
+	flag.String("lang", config.Envs.Locale, i18n.T("cli.flag.lang"))
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), i18n.T("cli.usage"))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) > 0 {
+		switch args[0] {
 		case "extract":
-			extract()
+			extract(args[1:])
+			return
+		case "site":
+			generateSite(args[1:])
+			return
+		case "show":
+			show(args[1:])
+			return
+		case "report":
+			generateReports(args[1:])
 			return
 		case "push":
-			if len(os.Args) < 5 {
-				fmt.Println("Usage: focus push <dateStr> <jsonRelPath> <commitMsg>")
-				return
-			}
-			repoPath := config.Envs.GitbookRepoPath
-			dateStr := os.Args[2]
-			jsonRelPath := os.Args[3]
-			commitMsg := os.Args[4]
-			if err := exporter.Push(repoPath, dateStr, jsonRelPath, commitMsg); err != nil {
-				log.Fatalf("Push 실패: %v", err)
-			}
-			fmt.Println("Push 완료!")
+			push(args[1:])
+			return
+		case "prune":
+			prune(args[1:])
 			return
 		}
 	}
-	fmt.Println("Usage: focus extract | push <dateStr> <jsonRelPath> <commitMsg>")
+	fmt.Println(i18n.T("cli.usage"))
 }
 
-func extract() {
+// extract: 어제 데이터 추출~그래프/리포트 생성, --publisher나 --dry-run을 주면 이어서 게시, --analysis면 시트 분석 탭 갱신
+// - dry-run(--dry-run, --publisher dry-run)이면 이미지 정리는 대상만 출력, 시트 분석 탭 쓰기는 건너뜀
+// - 알림은 게시 단계(publish)에서만 보냄 (게시 없이 추출만 하면 보내지 않음)
+func extract(args []string) {
+	fs := flag.NewFlagSet("extract", flag.ExitOnError)
+	pf := addPublishFlags(fs, "")
+	manifestPath := fs.String("manifest", exporter.ManifestFile, i18n.T("cli.flag.manifest"))
+	pruneAfter := fs.Bool("prune", config.Envs.PruneAfterExtract, i18n.T("cli.flag.prune"))
+	analysis := fs.Bool("analysis", config.Envs.AnalysisWriteBack, i18n.T("cli.flag.analysis"))
+	fs.Parse(args)
+
 	ctx := context.Background()
 	sheetsSrv, driveSrv, err := sheets.NewService(ctx)
 	if err != nil {
-		log.Fatalf("Google Sheets API 인증 실패: %v", err)
+		log.Fatal(i18n.T("cli.err.auth", err))
 	}
 	folderID := config.Envs.GSheetsParentFolderID
 	repoPath := config.Envs.GitbookRepoPath
 	repoDownloadPath := config.Envs.RepoDownloadPath
 
 	if folderID == "" {
-		log.Fatal("GSHEETS_PARENT_FOLDER_ID 환경변수를 설정하세요.")
+		log.Fatal(i18n.T("cli.err.env", "GSHEETS_PARENT_FOLDER_ID"))
 	}
 	if repoPath == "" {
-		log.Fatal("REPO_PATH 환경변수를 설정하세요.")
+		log.Fatal(i18n.T("cli.err.env", "REPO_PATH"))
 	}
 	if repoDownloadPath == "" {
-		log.Fatal("REPO_DOWNLOAD_PATH 환경변수를 설정하세요.")
+		log.Fatal(i18n.T("cli.err.env", "REPO_DOWNLOAD_PATH"))
+	}
+	opts := extractOptions()
+	opts.Prune = retentionPolicy(*pruneAfter)
+	opts.ManifestPath = *manifestPath
+	opts.DryRun = pf.name() == exporter.PublisherDryRun
+	m, err := exporter.Extract(ctx, sheetsSrv, driveSrv, folderID, repoPath, repoDownloadPath, time.Now(), opts)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.extract", err))
+	}
+	fmt.Println(i18n.T("cli.extractDone", m.Date, m.JSONPath, m.CommitMsg))
+	fmt.Println(i18n.T("cli.manifestDone", len(m.Artifacts), *manifestPath))
+	if opts.Prune != nil {
+		if opts.DryRun {
+			fmt.Println(i18n.T("cli.pruneDryRun", len(m.Pruned), *opts.Prune))
+		} else {
+			fmt.Println(i18n.T("cli.pruneDone", len(m.Pruned), *opts.Prune))
+		}
+	}
+	if pf.name() != "" {
+		publish(ctx, pf, m)
+	}
+	if *analysis {
+		if opts.DryRun {
+			fmt.Println(i18n.T("cli.analysisDryRun", sheets.AnalysisSheetTitle))
+		} else {
+			writeAnalysis(ctx, sheetsSrv, driveSrv, m)
+		}
+	}
+
+	// 이전 파이프라인 호환용 결과 한 줄 (focus push는 manifest를 읽음)
+	fmt.Printf("%s|%s|%s\n", m.Date, m.JSONPath, m.CommitMsg)
+}
+
+// extractOptions: 환경변수로 그래프/달력/히트맵/대시보드/피드 옵션 구성 (설정 오류면 종료)
+// - 게시 중 gitbook 충돌 뒤 전체 기록 산출물을 다시 만들 때도 같은 옵션을 씀
+func extractOptions() exporter.ExtractOptions {
+	render, err := renderOptions()
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.render", err))
+	}
+	panels, err := analyzer.ParsePanels(config.Envs.DashboardPanels)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.dash", err))
+	}
+	return exporter.ExtractOptions{
+		Render:           render,
+		CalendarCategory: config.Envs.CalendarCategory,
+		Heatmap: analyzer.WeekdayHeatmapOptions{
+			Category: config.Envs.HeatmapCategory,
+			Hourly:   config.Envs.HeatmapHourly,
+		},
+		Dashboard: analyzer.DashboardOptions{
+			Panels:  panels,
+			Columns: config.Envs.DashboardColumns,
+		},
+		Feed: feedOptions(),
+	}
+}
+
+// renderOptions: 환경변수(CHART_FORMAT, CHART_WIDTH, CHART_HEIGHT, CHART_DPI)와 표시 언어로 그래프 렌더 옵션 구성
+func renderOptions() (analyzer.RenderOptions, error) {
+	format, err := analyzer.ParseFormat(config.Envs.ChartFormat)
+	if err != nil {
+		return analyzer.RenderOptions{}, err
+	}
+	opts := analyzer.DefaultRenderOptions()
+	opts.Format = format
+	if config.Envs.ChartWidth > 0 {
+		opts.Width = vg.Points(float64(config.Envs.ChartWidth))
+	}
+	if config.Envs.ChartHeight > 0 {
+		opts.Height = vg.Points(float64(config.Envs.ChartHeight))
+	}
+	if config.Envs.ChartDPI > 0 {
+		opts.DPI = config.Envs.ChartDPI
 	}
-	dateStr, jsonRelPath, commitMsg, err := exporter.Extract(ctx, sheetsSrv, driveSrv, folderID, repoPath, repoDownloadPath, time.Now())
+	theme, err := analyzer.ThemeByName(config.Envs.ChartTheme)
 	if err != nil {
-		log.Fatalf("Extract 실패: %v", err)
+		return analyzer.RenderOptions{}, err
 	}
-	fmt.Printf("추출 완료! dateStr: %s, jsonRelPath: %s, commitMsg: %s\n", dateStr, jsonRelPath, commitMsg)
+	opts.Theme = &theme
+	opts.NoWatermark = config.Envs.ChartNoWatermark
+	opts.Locale = i18n.Default().Locale()
+	return opts, nil
+}
+
+// generateSite: dailydata/raw 전체로 인터랙티브 HTML 대시보드 생성
+// - 기본 출력: GITBOOK_REPO_PATH/REPO_DOWNLOAD_PATH/focus-dashboard.html (그래프와 같은 경로)
+func generateSite(args []string) {
+	fs := flag.NewFlagSet("site", flag.ExitOnError)
+	defaultOut := filepath.Join(config.Envs.GitbookRepoPath, config.Envs.RepoDownloadPath, site.FileName)
+	out := fs.String("out", defaultOut, i18n.T("cli.flag.out"))
+	rawDir := fs.String("raw", filepath.Join("dailydata", "raw"), i18n.T("cli.flag.raw"))
+	fs.Parse(args)
 
-	// 파일 저장 대신 표준 출력으로 결과만 출력 (CI/CD 연동)
-	fmt.Printf("%s|%s|%s\n", dateStr, jsonRelPath, commitMsg)
+	data, err := exporter.LoadAllFocusData(*rawDir)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.load", err))
+	}
+	if err := site.Generate(data, *out, time.Now(), i18n.Default()); err != nil {
+		log.Fatal(i18n.T("cli.err.site", err))
+	}
+	fmt.Println(i18n.T("cli.siteDone", *out))
+}
+
+// publishFlags: push/extract 공통 게시 플래그
+type publishFlags struct {
+	publisher *string
+	dryRun    *bool
+	localDir  *string
+}
+
+// addPublishFlags: fs에 --publisher, --dry-run, --local-dir 등록
+// - defaultPublisher: --publisher 기본값 (push는 PUBLISHER 환경변수, extract는 비워서 게시 안 함)
+func addPublishFlags(fs *flag.FlagSet, defaultPublisher string) *publishFlags {
+	return &publishFlags{
+		publisher: fs.String("publisher", defaultPublisher, i18n.T("cli.flag.publisher")),
+		dryRun:    fs.Bool("dry-run", false, i18n.T("cli.flag.dryRun")),
+		localDir:  fs.String("local-dir", config.Envs.PublishLocalDir, i18n.T("cli.flag.localDir")),
+	}
+}
+
+// name: 사용할 게시 백엔드 이름 (--dry-run이 --publisher보다 우선)
+func (f *publishFlags) name() string {
+	if *f.dryRun {
+		return exporter.PublisherDryRun
+	}
+	return *f.publisher
+}
+
+// push: extract가 남긴 manifest의 산출물을 게시 백엔드로 게시 (git 백엔드는 PUBLISH_MODE에 따라 main 직접 push 또는 날짜 브랜치 PR)
+// - 이전 형식의 <dateStr> <jsonRelPath> <commitMsg> 인자를 주면 manifest 날짜와 맞는지 확인하고 커밋 메시지만 덮어씀
+func push(args []string) {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+	pf := addPublishFlags(fs, config.Envs.Publisher)
+	manifestPath := fs.String("manifest", exporter.ManifestFile, i18n.T("cli.flag.manifest"))
+	fs.Parse(args)
+	if fs.NArg() != 0 && fs.NArg() != 3 {
+		fmt.Println(i18n.T("cli.pushUsage"))
+		return
+	}
+	m, err := exporter.LoadManifest(*manifestPath)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.manifest", err))
+	}
+	if fs.NArg() == 3 {
+		if fs.Arg(0) != m.Date {
+			log.Fatal(i18n.T("cli.err.manifestDate", fs.Arg(0), m.Date, *manifestPath))
+		}
+		m.CommitMsg = fs.Arg(2)
+	}
+	publish(context.Background(), pf, m)
+}
+
+// publish: 플래그/환경변수로 게시 백엔드를 골라 manifest 산출물 게시 후 결과 출력
+// - 알림: git 게시가 실제로 반영됐을 때만 전송 (push, 또는 PR이 모두 머지됨), dry-run은 메시지만 로그, local은 보내지 않음
+func publish(ctx context.Context, pf *publishFlags, m *exporter.Manifest) {
+	mode, err := exporter.ParsePublishMode(config.Envs.PublishMode)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.publisher", err))
+	}
+	history := extractOptions()
+	publisher, err := exporter.NewPublisher(pf.name(), exporter.PublisherOptions{
+		Mode:    mode,
+		History: &history,
+		PR: exporter.PROptions{
+			APIURL:         config.Envs.GitHubAPIURL,
+			Token:          config.Envs.GH_TOKEN,
+			AutoMerge:      config.Envs.PRAutoMerge,
+			RequiredChecks: splitList(config.Envs.PRRequiredChecks),
+			MergeMethod:    config.Envs.PRMergeMethod,
+			CheckGrace:     time.Duration(config.Envs.PRCheckGrace) * time.Minute,
+			CheckTimeout:   time.Duration(config.Envs.PRCheckTimeout) * time.Minute,
+		},
+		LocalDir: *pf.localDir,
+	})
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.publisher", err))
+	}
+
+	result, err := publisher.Publish(ctx, m)
+	if result != nil {
+		for _, pr := range result.PullRequests {
+			fmt.Println(i18n.T("cli.prDone", pr.Number, pr.HTMLURL, pr.Merged))
+		}
+	}
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.push", err))
+	}
+	switch publisher.Name() {
+	case exporter.PublisherLocal:
+		fmt.Println(i18n.T("cli.localDone", len(result.Files), *pf.localDir))
+	case exporter.PublisherDryRun:
+		fmt.Println(i18n.T("cli.dryRunDone", len(result.Files)))
+		notifySummary(ctx, m, history.Render, true)
+	default:
+		if len(result.PullRequests) == 0 {
+			fmt.Println(i18n.T("cli.pushDone"))
+		}
+		if published(mode, result.PullRequests) {
+			notifySummary(ctx, m, history.Render, false)
+		} else {
+			log.Print("[notify] 머지된 PR이 없어 알림 생략")
+		}
+	}
+}
+
+// published: git 게시 결과가 base 브랜치에 반영됐는지 (push는 항상, PR은 하나 이상 있고 모두 머지됨)
+func published(mode exporter.PublishMode, prs []*github.PullRequest) bool {
+	if mode == exporter.PublishModePush {
+		return true
+	}
+	for _, pr := range prs {
+		if !pr.Merged {
+			return false
+		}
+	}
+	return len(prs) > 0
 }
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/config"
+	"github.com/crispy/focus-time-tracker/internal/exporter"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"github.com/crispy/focus-time-tracker/internal/notify"
+)
+
+// splitList: 쉼표 구분 환경변수 값을 공백/빈 항목 없이 나눔
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// notifyConfig: 환경변수로 알림 백엔드 설정 구성
+func notifyConfig() notify.Config {
+	return notify.Config{
+		WebhookURL:      config.Envs.NotifyWebhookURL,
+		SlackWebhookURL: config.Envs.SlackWebhookURL,
+		SMTP: notify.SMTPConfig{
+			Host:     config.Envs.SMTPHost,
+			Port:     config.Envs.SMTPPort,
+			Username: config.Envs.SMTPUsername,
+			Password: config.Envs.SMTPPassword,
+			From:     config.Envs.SMTPFrom,
+			To:       splitList(config.Envs.SMTPTo),
+		},
+	}
+}
+
+// notifySummary: 추출한 날의 합계, 최근 7일 트렌드 평가, 트렌드 그래프를 설정된 채널로 전송
+// - 알림 실패는 추출 결과에 영향을 주지 않도록 로그만 남김
+// - dryRun: 보내지 않고 메시지만 로그로 남김
+func notifySummary(ctx context.Context, m *exporter.Manifest, render analyzer.RenderOptions, dryRun bool) {
+	notifiers := notify.New(notifyConfig())
+	if len(notifiers) == 0 {
+		return
+	}
+	msg, err := summaryMessage(m, render)
+	if err != nil {
+		log.Print(i18n.T("cli.err.notify", err))
+		return
+	}
+	if dryRun {
+		log.Printf("[notify] dry-run: %d개 채널 전송 생략\n%s\n%s", len(notifiers), msg.Title, msg.Text)
+		return
+	}
+	if err := notify.Send(ctx, notifiers, msg); err != nil {
+		log.Print(i18n.T("cli.err.notify", err))
+		return
+	}
+	fmt.Println(i18n.T("cli.notifyDone", len(notifiers)))
+}
+
+// summaryMessage: manifest의 일별 JSON과 트렌드 그래프로 알림 메시지 구성
+func summaryMessage(m *exporter.Manifest, render analyzer.RenderOptions) (notify.Message, error) {
+	tr := i18n.New(render.Locale)
+	day, err := exporter.ReadFocusDataFile(m.JSONPath)
+	if err != nil {
+		return notify.Message{}, err
+	}
+	recent, err := exporter.LoadRecentFocusData(filepath.Join("dailydata", "raw"), 7)
+	if err != nil {
+		return notify.Message{}, err
+	}
+	tmpl, err := notify.ParseTemplate(config.Envs.NotifyTemplateFile, tr)
+	if err != nil {
+		return notify.Message{}, err
+	}
+	msg, err := notify.NewMessage(notify.NewSummary(day, analyzer.EvalText(recent, render), tr), tmpl, tr)
+	if err != nil {
+		return notify.Message{}, err
+	}
+	if graphs := m.Files(exporter.RepoMain, exporter.KindGraph); len(graphs) > 0 {
+		if msg.Image, err = notify.LoadAttachment(graphs[0]); err != nil {
+			return notify.Message{}, err
+		}
+	}
+	msg.ImageURL = config.Envs.NotifyImageURL
+	return msg, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/config"
+	"github.com/crispy/focus-time-tracker/internal/exporter"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+// prune: 보존 정책에 따라 dailydata의 오래된 날짜별 이미지 삭제 (raw JSON은 건드리지 않음, 커밋은 하지 않음)
+// - --dry-run이면 삭제할 파일만 출력
+func prune(args []string) {
+	fs := flag.NewFlagSet("prune", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, i18n.T("cli.flag.pruneDryRun"))
+	keep := fs.String("keep", config.Envs.Retention, i18n.T("cli.flag.keep"))
+	fs.Parse(args)
+
+	policy, err := exporter.ParseRetention(*keep)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.prune", err))
+	}
+	removed, err := exporter.Prune(exporter.PruneDirs, policy, time.Now(), *dryRun)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.prune", err))
+	}
+	for _, path := range removed {
+		fmt.Printf("  D %s\n", path)
+	}
+	if *dryRun {
+		fmt.Println(i18n.T("cli.pruneDryRun", len(removed), policy))
+		return
+	}
+	fmt.Println(i18n.T("cli.pruneDone", len(removed), policy))
+}
+
+// retentionPolicy: extract --prune에 쓸 보존 정책 (꺼져 있으면 nil)
+func retentionPolicy(enabled bool) *exporter.RetentionPolicy {
+	if !enabled {
+		return nil
+	}
+	policy, err := exporter.ParseRetention(config.Envs.Retention)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.prune", err))
+	}
+	return &policy
+}
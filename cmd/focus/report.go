@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/crispy/focus-time-tracker/internal/config"
+	"github.com/crispy/focus-time-tracker/internal/exporter"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"github.com/crispy/focus-time-tracker/internal/report"
+)
+
+// generateReports: 저장된 FocusData로 GitBook 일간/주간 Markdown 리포트 생성 (push는 하지 않음)
+// - 기본: 마지막 기록일 하루, -date로 날짜 지정, -all이면 모든 날짜 다시 생성
+func generateReports(args []string) {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	date := fs.String("date", "", i18n.T("cli.flag.date"))
+	all := fs.Bool("all", false, i18n.T("cli.flag.all"))
+	rawDir := fs.String("raw", filepath.Join("dailydata", "raw"), i18n.T("cli.flag.raw"))
+	fs.Parse(args)
+
+	data, err := exporter.LoadAllFocusData(*rawDir)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.load", err))
+	}
+	if len(data) == 0 {
+		log.Fatal(i18n.T("cli.err.noData", *rawDir))
+	}
+	render, err := renderOptions()
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.render", err))
+	}
+	opts := report.Options{
+		RepoPath:  config.Envs.GitbookRepoPath,
+		AssetsDir: config.Envs.RepoDownloadPath,
+		Render:    render,
+		Feed:      feedOptions(),
+	}
+
+	dates := []string{data[len(data)-1].Date}
+	if *date != "" {
+		dates = []string{*date}
+	}
+	if *all {
+		dates = dates[:0]
+		for _, d := range data {
+			dates = append(dates, d.Date)
+		}
+	}
+	for _, d := range dates {
+		if _, err := report.Generate(data, d, opts); err != nil {
+			log.Fatal(i18n.T("cli.err.report", err))
+		}
+	}
+	fmt.Println(i18n.T("cli.reportDone", len(dates), filepath.Join(opts.RepoPath, report.Dir)))
+}
+
+// feedOptions: 환경변수의 리포트 Atom 피드 옵션
+func feedOptions() report.FeedOptions {
+	return report.FeedOptions{
+		MaxEntries: config.Envs.FeedMaxEntries,
+		BaseURL:    config.Envs.FeedBaseURL,
+	}
+}
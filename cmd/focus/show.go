@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/crispy/focus-time-tracker/internal/exporter"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"github.com/crispy/focus-time-tracker/internal/termchart"
+)
+
+// show: 최근 N일 데이터를 터미널 차트(스파크라인, 막대, 타임라인, 표)로 출력
+func show(args []string) {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	days := fs.Int("days", 14, i18n.T("cli.flag.days"))
+	rawDir := fs.String("raw", filepath.Join("dailydata", "raw"), i18n.T("cli.flag.raw"))
+	fs.Parse(args)
+
+	data, err := exporter.LoadRecentFocusData(*rawDir, *days)
+	if err != nil {
+		log.Fatal(i18n.T("cli.err.load", err))
+	}
+	if err := termchart.Render(os.Stdout, data, i18n.Default()); err != nil {
+		log.Fatal(i18n.T("cli.err.show", err))
+	}
+}
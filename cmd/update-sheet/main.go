@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/crispy/focus-time-tracker/internal/common"
 	"github.com/crispy/focus-time-tracker/internal/config"
 	"github.com/crispy/focus-time-tracker/internal/sheets"
 )
@@ -16,14 +17,21 @@ func main() {
 		spreadsheetID string
 		year          int
 		folderID      string
+		categories    bool
 	)
 	config.LoadEnv()
 	currentYear := time.Now().In(time.FixedZone("KST", 9*60*60)).Year()
 	flag.StringVar(&spreadsheetID, "id", "", "업그레이드할 Google Spreadsheet ID (없으면 자동 검색)")
 	flag.IntVar(&year, "year", currentYear, "업그레이드할 연도 (기본: 올해)")
 	flag.StringVar(&folderID, "folder", config.Envs.GSheetsParentFolderID, "Google Drive 폴더 ID (기본: config.Envs.GSheetsParentFolderID)")
+	flag.BoolVar(&categories, "categories", false, "데이터는 그대로 두고 카테고리 드롭다운/조건부 색상과 요약 탭만 카테고리 설정 파일대로 갱신")
 	flag.Parse()
 
+	if err := config.LoadCategories(); err != nil {
+		fmt.Printf("카테고리 설정 로드 실패: %v\n", err)
+		os.Exit(1)
+	}
+
 	ctx := context.Background()
 	sheetsSrv, driveSrv, err := sheets.NewService(ctx)
 	if err != nil {
@@ -44,6 +52,27 @@ func main() {
 		fmt.Printf("자동으로 찾은 스프레드시트 ID: %s\n", spreadsheetID)
 	}
 
+	if categories {
+		fmt.Printf("[%d년] 스프레드시트(%s) 카테고리 규칙 갱신 시작 (%d개 카테고리)...\n", year, spreadsheetID, len(common.Categories))
+		n, err := sheets.UpdateCategoryRules(sheetsSrv, spreadsheetID)
+		if err != nil {
+			fmt.Printf("카테고리 규칙 갱신 실패: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("카테고리 규칙 갱신 완료! (%d개 시트)\n", n)
+		// 요약 탭 열도 카테고리를 따르므로 다시 생성
+		layout, err := sheets.GetSlotLayout(sheetsSrv, spreadsheetID)
+		if err == nil {
+			err = sheets.AddSummarySheet(sheetsSrv, spreadsheetID, year, layout)
+		}
+		if err != nil {
+			fmt.Printf("%s 탭 갱신 실패: %v\n", sheets.SummarySheetTitle, err)
+			os.Exit(1)
+		}
+		fmt.Printf("%s 탭 갱신 완료!\n", sheets.SummarySheetTitle)
+		return
+	}
+
 	// 오늘 날짜(한국시간) 기준으로 내일부터 연말까지 업그레이드
 	loc := time.FixedZone("KST", 9*60*60)
 	today := time.Now().In(loc)
@@ -0,0 +1,11 @@
+// Package fonts: 그래프 렌더링에 쓰는 기본 한글 폰트를 바이너리에 포함
+package fonts
+
+import (
+	_ "embed"
+)
+
+// D2Coding: 기본 한글 폰트 (D2Coding 1.3.2, SIL Open Font License)
+//
+//go:embed D2Coding/D2Coding-Ver1.3.2-20180524.ttf
+var D2Coding []byte
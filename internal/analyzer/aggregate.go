@@ -1,17 +1,25 @@
 package analyzer
 
 import (
-	"fmt"
-
 	"github.com/crispy/focus-time-tracker/internal/common"
 	"gonum.org/v1/gonum/stat"
 )
 
-// AnalyzeFocus: 10분 단위 라벨/집중도 데이터 → FocusData 집계
+// AnalyzeFocus: 10분 단위 라벨/집중도 데이터 → FocusData 집계 (기본 슬롯 구성의 AnalyzeSlots)
 // - labels: 각 10분 구간의 카테고리명 배열
 // - scores: 각 10분 구간의 집중도 점수 배열
-// 반환: FocusData (카테고리별 합계, 총점, 시간대별 점수)
+// - 빈 라벨("")도 인덱스를 유지 (i번째 = i번째 10분 구간), 점수는 시간대 합계에만 들어감
+// 반환: FocusData (카테고리별 합계, 총점, 시간대별 점수/카테고리)
 func AnalyzeFocus(labels []string, scores []int) common.FocusData {
+	return AnalyzeSlots(labels, scores, common.DefaultSlotLayout)
+}
+
+// AnalyzeSlots: layout 슬롯 단위 라벨/집중도 데이터 → FocusData 집계
+// - labels, scores: 각 슬롯의 카테고리명/집중도 점수 배열 (i번째 = i번째 슬롯, 빈 라벨 포함)
+// - 카테고리에 없는 라벨(빈 칸 포함)의 점수는 카테고리 합계/총점에는 빠지고 시간대 합계에만 들어감
+// - layout: 슬롯 길이 (시간대 키 계산, 결과 SlotMinutes에 기록)
+// 반환: FocusData (카테고리별 합계, 총점, 시간대별 점수/카테고리, 슬롯 길이)
+func AnalyzeSlots(labels []string, scores []int, layout common.SlotLayout) common.FocusData {
 	categories := make(map[string]int) // 카테고리별 점수 합계
 	maxScore := make(map[string]int)   // 카테고리별 최대 점수
 	for _, cat := range common.Categories {
@@ -20,6 +28,7 @@ func AnalyzeFocus(labels []string, scores []int) common.FocusData {
 	}
 	totalFocus := 0 // 하루 총 몰입 점수
 	timeSlots := make(map[string]int) // 시간대별 점수 합계 (ex: "09:30" -> 40)
+	slotLabels := make(map[string]string) // 시간대별 카테고리 (ex: "09:30" -> "업무")
 	for i, label := range labels {
 		score := 0
 		if i < len(scores) {
@@ -27,39 +36,60 @@ func AnalyzeFocus(labels []string, scores []int) common.FocusData {
 		}
 		if _, ok := categories[label]; ok {
 			categories[label] += score // 카테고리별 합산
-			maxScore[label] += 5       // 해당 카테고리 row 수 * 5
-			if label != "이동" {
-				totalFocus += score // "이동" 제외 총점
+			maxScore[label] += common.MaxSlotScore // 해당 카테고리 row 수 * 최대 점수
+			if common.IsCounted(label) {
+				totalFocus += score // 총점 제외 카테고리(기본 "이동")는 빼고 합산
 			}
 		}
-		// 시간대별 몰입 합계 계산 (10분 단위)
-		hour := i / 6
-		min := (i % 6) * 10
-		timeKey := fmt.Sprintf("%02d:%02d", hour, min)
+		// 시간대별 몰입 합계 계산 (슬롯 단위)
+		timeKey := layout.Key(i)
 		timeSlots[timeKey] += score
+		if _, ok := categories[label]; ok {
+			slotLabels[timeKey] = label
+		}
 	}
 	return common.FocusData{
 		Categories: categories,
 		TotalFocus: totalFocus,
 		MaxScore:   maxScore,
 		TimeSlots:  timeSlots,
+		SlotLabels: slotLabels,
+		SlotMinutes: layout.SlotMinutes(),
 	}
 }
 
+// AnalyzeDaySlots: 시트 슬롯 원본(빈 칸 포함) → FocusData 집계
+// - 빈 칸을 지우지 않고 그대로 넘겨 시간대 키가 실제 시트 행과 일치하도록 함
+// 반환: Date가 채워진 FocusData
+func AnalyzeDaySlots(slots common.DaySlots) common.FocusData {
+	data := AnalyzeSlots(slots.Labels, slots.Scores, common.SlotLayout{Minutes: slots.SlotMinutes})
+	data.Date = slots.Date
+	return data
+}
+
 // Regression: 카테고리별 회귀 분석 (gonum/stat 활용)
 // - data: 여러 일자의 FocusData 배열
 // - category: 분석할 카테고리명
 // 반환: slope(기울기), intercept(절편)
 func Regression(data []common.FocusData, category string) (slope, intercept float64) {
-	xs := make([]float64, len(data)) // x축: 일자 인덱스
 	ys := make([]float64, len(data)) // y축: 해당 카테고리 점수
 	for i, d := range data {
-		xs[i] = float64(i)
 		ys[i] = float64(d.Categories[category])
 	}
-	if len(xs) < 2 {
+	return linearFit(ys)
+}
+
+// linearFit: 값 배열의 최소제곱 회귀 직선 (x = 일자 인덱스)
+// - stat.LinearRegression은 (절편, 기울기) 순서로 반환하므로 여기서만 순서를 맞춤
+// 반환: slope(기울기), intercept(절편) (값 2개 미만이면 0, 0)
+func linearFit(ys []float64) (slope, intercept float64) {
+	if len(ys) < 2 {
 		return 0, 0 // 데이터 2개 미만이면 회귀 불가
 	}
-	slope, intercept = stat.LinearRegression(xs, ys, nil, false)
+	xs := make([]float64, len(ys)) // x축: 일자 인덱스
+	for i := range xs {
+		xs[i] = float64(i)
+	}
+	intercept, slope = stat.LinearRegression(xs, ys, nil, false)
 	return
 }
@@ -2,32 +2,48 @@ package analyzer
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/plotter"
 )
 
 // PreparePlotData: plotting에 필요한 데이터(카테고리별 점, 회귀선, 평가 텍스트, 워터마크) 생성
 // - data: 여러 일자의 FocusData 배열
+// - now: 워터마크 기준 시각
+// - tr: 평가 텍스트 언어 (그래프 렌더와 같은 RenderOptions의 Printer를 넘김)
 // 반환: 카테고리별 점(points), 회귀선(regressionLines), 평가 텍스트, 워터마크 문자열
-func PreparePlotData(data []common.FocusData) (map[string]plotter.XYs, map[string]plotter.XYs, string, string) {
+func PreparePlotData(data []common.FocusData, now time.Time, tr i18n.Printer) (map[string]plotter.XYs, map[string]plotter.XYs, string, string) {
 	points := map[string]plotter.XYs{} // 카테고리별 실제 점 데이터
 	regressionLines := map[string]plotter.XYs{} // 카테고리별 회귀선 데이터
 	for _, cat := range common.Categories {
 		points[cat] = makeCategoryPoints(data, cat) // 실제 점 생성
 		regressionLines[cat] = makeRegressionPoints(data, cat) // 회귀선 생성
 	}
-	evalText := makeEvalText(data) // 카테고리별 트렌드 평가 텍스트
-	watermark := makeWatermark() // 워터마크(날짜/시간)
+	evalText := makeEvalText(data, tr) // 카테고리별 트렌드 평가 텍스트
+	watermark := makeWatermark(now) // 워터마크(날짜/시간)
 	return points, regressionLines, evalText, watermark
 }
 
 // PlotFocusTrendsAndRegression: 분석 및 시각화 전체 orchestration 함수
 // - data: 여러 일자의 FocusData 배열
-// 반환: PNG 이미지 []byte, 에러
+// - opts: 출력 포맷/크기/DPI, 테마, 기준 시각/워터마크
+// 반환: 이미지 []byte, 에러
 // 1. plot용 데이터 준비(점, 회귀선, 텍스트)
 // 2. plot.go의 DrawFocusTrends로 그림 생성
-func PlotFocusTrendsAndRegression(data []common.FocusData) ([]byte, error) {
+func PlotFocusTrendsAndRegression(data []common.FocusData, opts RenderOptions) ([]byte, error) {
+	p, err := focusTrendsFromData(data, opts)
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// focusTrendsFromData: FocusData로 트렌드/회귀선 plot 구성 (정규화, 카테고리 정렬, 전체 평균선 포함)
+func focusTrendsFromData(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
 	if len(data) == 0 {
 		return nil, fmt.Errorf("분석할 데이터가 없습니다")
 	}
@@ -39,10 +55,20 @@ func PlotFocusTrendsAndRegression(data []common.FocusData) ([]byte, error) {
 			categorySet[cat] = struct{}{}
 		}
 	}
+	// 실행마다 같은 그림이 나오도록 common.Categories 순서 + 나머지 이름순으로 정렬
 	categories := make([]string, 0, len(categorySet))
+	for _, cat := range common.Categories {
+		if _, ok := categorySet[cat]; ok {
+			categories = append(categories, cat)
+			delete(categorySet, cat)
+		}
+	}
+	extra := make([]string, 0, len(categorySet))
 	for cat := range categorySet {
-		categories = append(categories, cat)
+		extra = append(extra, cat)
 	}
+	sort.Strings(extra)
+	categories = append(categories, extra...)
 
 	// 2. 정규화된 데이터 준비 (카테고리별 MaxScore[cat] > 0인 날만)
 	normData := make([]common.FocusData, 0, len(data))
@@ -72,8 +98,8 @@ func PlotFocusTrendsAndRegression(data []common.FocusData) ([]byte, error) {
 		points[cat] = makeCategoryPoints(normData, cat)
 		regressionLines[cat] = makeRegressionPoints(normData, cat)
 	}
-	evalText := makeEvalText(normData)
-	watermark := makeWatermark()
+	evalText := makeEvalText(normData, opts.printer())
+	watermark := opts.watermark()
 
 	// 4. aggregateLine 계산: 동적 카테고리별로 모든 일자의 평균 (0점 제외), MaxScore로 비율화
 	totalAverages := make([]float64, len(categories))
@@ -114,17 +140,33 @@ func PlotFocusTrendsAndRegression(data []common.FocusData) ([]byte, error) {
 		aggregateLine[i].Y = ratio
 	}
 
-	// 5. DrawFocusTrends에 동적 카테고리 전달
-	return DrawFocusTrends(points, regressionLines, evalText, watermark, aggregateLine, normData, categories)
+	// 5. 동적 카테고리로 plot 구성
+	return focusTrendsPlot(points, regressionLines, evalText, watermark, aggregateLine, normData, categories, opts.theme(), opts.printer(), opts.now())
 }
 
-// PlotTimeSlotAverageFocusAggregatePNG: 전체 데이터를 합산하여 단일 평균 라인 그래프를 그림
+// PlotTimeSlotAverageFocusAggregatePNG: 전체 데이터를 합산하여 단일 평균 라인 그래프를 PNG로 그림
 // - data: 여러 일자의 FocusData 배열
 // 반환: PNG 이미지 []byte, 에러
 func PlotTimeSlotAverageFocusAggregatePNG(data []common.FocusData) ([]byte, error) {
+	return PlotTimeSlotAverageFocusAggregate(data, DefaultRenderOptions())
+}
+
+// PlotTimeSlotAverageFocusAggregate: 전체 데이터를 합산하여 단일 평균 라인 그래프를 opts 포맷으로 그림
+// - data: 여러 일자의 FocusData 배열
+// - opts: 출력 포맷/크기/DPI
+// 반환: 이미지 []byte, 에러
+func PlotTimeSlotAverageFocusAggregate(data []common.FocusData, opts RenderOptions) ([]byte, error) {
 	if len(data) == 0 {
 		return nil, fmt.Errorf("분석할 데이터가 없습니다")
 	}
+	return PlotTimeSlotAverageFocus([]common.FocusData{aggregateTimeSlots(data)}, opts)
+}
+
+// aggregateDate: aggregateTimeSlots 결과의 Date 값 (범례에서는 언어별 문구로 표시)
+const aggregateDate = "Aggregate"
+
+// aggregateTimeSlots: 모든 일자의 시간대별 평균 점수(0점 제외, 반올림)를 하나의 FocusData로 합산
+func aggregateTimeSlots(data []common.FocusData) common.FocusData {
 	// 시간대별 합산 및 평균 계산 (0점 제외)
 	timeSlotSum := map[string]int{}
 	timeSlotCount := map[string]int{}
@@ -138,7 +180,7 @@ func PlotTimeSlotAverageFocusAggregatePNG(data []common.FocusData) ([]byte, erro
 		}
 	}
 	agg := common.FocusData{
-		Date:      "Aggregate",
+		Date:      aggregateDate,
 		TimeSlots: map[string]int{},
 	}
 	for t, sum := range timeSlotSum {
@@ -146,6 +188,5 @@ func PlotTimeSlotAverageFocusAggregatePNG(data []common.FocusData) ([]byte, erro
 			agg.TimeSlots[t] = int(float64(sum) / float64(timeSlotCount[t]) + 0.5) // 반올림
 		}
 	}
-	
-	return PlotTimeSlotAverageFocusPNG([]common.FocusData{agg})
+	return agg
 }
@@ -1,9 +1,21 @@
 package analyzer
 
 import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image/color"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
 )
 
 func TestAnalyzeFocus(t *testing.T) {
@@ -22,18 +34,131 @@ func TestAnalyzeFocus(t *testing.T) {
 	}
 }
 
+func TestAnalyzeFocus_ConfiguredCategories(t *testing.T) {
+	t.Cleanup(func() { common.SetCategories(common.DefaultCategoryDefs) })
+	if err := common.SetCategories([]common.CategoryDef{{ID: "코딩", Counted: true}, {ID: "휴식"}}); err != nil {
+		t.Fatal(err)
+	}
+	result := AnalyzeFocus([]string{"코딩", "휴식", "업무"}, []int{50, 30, 20})
+	if result.TotalFocus != 50 {
+		t.Errorf("TotalFocus = %d, want 50 (휴식은 총점 제외, 업무는 정의에 없음)", result.TotalFocus)
+	}
+	if _, ok := result.Categories["업무"]; ok || result.Categories["휴식"] != 30 || result.MaxScore["휴식"] != 5 {
+		t.Errorf("카테고리 집계 이상: %v / %v", result.Categories, result.MaxScore)
+	}
+}
+
+func TestAnalyzeSlots_Layout(t *testing.T) {
+	layout := common.SlotLayout{Minutes: 15}
+	labels := make([]string, layout.PerDay())
+	scores := make([]int, layout.PerDay())
+	labels[37], scores[37] = "업무", 4 // 09:15
+	labels[95], scores[95] = "학습", 3 // 23:45
+	scores[2] = 2                     // 00:30, 라벨 없는 칸의 점수
+	result := AnalyzeSlots(labels, scores, layout)
+	if result.SlotMinutes != 15 || result.TimeSlots["09:15"] != 4 || result.SlotLabels["23:45"] != "학습" {
+		t.Errorf("15분 슬롯 집계 이상: %+v", result)
+	}
+	// 빈 라벨 칸: 시간대 점수에는 남고 카테고리/총점에는 안 들어감
+	if _, ok := result.SlotLabels["00:30"]; result.TimeSlots["00:30"] != 2 || ok || result.TotalFocus != 7 || len(result.TimeSlots) != layout.PerDay() {
+		t.Errorf("빈 라벨 칸 집계 이상: %v점, 총점 %d, 시간대 %d개", result.TimeSlots["00:30"], result.TotalFocus, len(result.TimeSlots))
+	}
+	if d := AnalyzeDaySlots(common.DaySlots{Date: "2024-06-01", Labels: []string{"업무"}, Scores: []int{1}}); d.SlotMinutes != common.DefaultSlotMinutes || d.TimeSlots["00:00"] != 1 {
+		t.Errorf("기본 슬롯 길이 이상: %+v", d)
+	}
+}
+
+func TestResample(t *testing.T) {
+	// 5분 → 10분: 두 슬롯 평균, 가장 오래 기록된 카테고리 (같으면 먼저 나온 것)
+	fine := common.FocusData{
+		Date: "2024-06-01", TotalFocus: 9, SlotMinutes: 5,
+		Categories: map[string]int{"업무": 5, "학습": 4}, MaxScore: map[string]int{"업무": 10, "학습": 10},
+		TimeSlots:  map[string]int{"09:00": 2, "09:05": 3, "09:10": 4},
+		SlotLabels: map[string]string{"09:00": "학습", "09:05": "업무", "09:10": "업무"},
+	}
+	got := Resample(fine, 10)
+	if got.SlotMinutes != 10 || got.TimeSlots["09:00"] != 3 || got.TimeSlots["09:10"] != 2 || len(got.TimeSlots) != 2 {
+		t.Errorf("5분→10분 TimeSlots 이상: %v", got.TimeSlots)
+	}
+	if got.SlotLabels["09:00"] != "학습" || got.SlotLabels["09:10"] != "업무" {
+		t.Errorf("5분→10분 SlotLabels 이상: %v", got.SlotLabels)
+	}
+	if got.TotalFocus != 5 || got.MaxScore["업무"] != 5 || got.Categories["학습"] != 2 {
+		t.Errorf("5분→10분 합계 이상: %+v", got)
+	}
+	if fine.TimeSlots["09:05"] != 3 || fine.SlotMinutes != 5 {
+		t.Errorf("원본이 바뀜: %+v", fine)
+	}
+
+	// 30분 → 10분: 같은 점수로 세 칸
+	coarse := common.FocusData{TotalFocus: 4, SlotMinutes: 30, TimeSlots: map[string]int{"23:30": 4}, SlotLabels: map[string]string{"23:30": "수면"}}
+	got = Resample(coarse, 0)
+	for _, key := range []string{"23:30", "23:40", "23:50"} {
+		if got.TimeSlots[key] != 4 || got.SlotLabels[key] != "수면" {
+			t.Errorf("30분→10분 %s 이상: %v / %v", key, got.TimeSlots, got.SlotLabels)
+		}
+	}
+	if got.TotalFocus != 12 || len(got.TimeSlots) != 3 {
+		t.Errorf("30분→10분 합계 이상: %+v", got)
+	}
+
+	// 슬롯 길이 기록이 없는 이전 JSON은 10분으로 보고 그대로 둠
+	legacy := common.FocusData{TotalFocus: 7, TimeSlots: map[string]int{"10:10": 7}}
+	history := ResampleHistory([]common.FocusData{legacy, fine}, common.DefaultSlotMinutes)
+	if history[0].SlotMinutes != 10 || history[0].TimeSlots["10:10"] != 7 || history[1].TimeSlots["09:00"] != 3 {
+		t.Errorf("ResampleHistory 이상: %+v", history)
+	}
+}
+
+func TestTrends(t *testing.T) {
+	totals := []int{10, 12, 11, 13, 12, 40, 12}
+	data := make([]common.FocusData, len(totals))
+	for i, v := range totals {
+		data[i] = common.FocusData{Date: fmt.Sprintf("2024-06-%02d", i+1), TotalFocus: v, Categories: map[string]int{"업무": i * 2}}
+	}
+	trends := Trends(data, TrendOptions{Window: 3})
+	if len(trends) != len(data) || trends[6].Date != "2024-06-07" {
+		t.Fatalf("Trends 결과 이상: %+v", trends)
+	}
+	if trends[0].Rolling != 10 || trends[0].Slope != 0 || trends[0].Anomaly != AnomalyNone {
+		t.Errorf("첫날 이상: %+v", trends[0])
+	}
+	if math.Abs(trends[2].Rolling-11) > 1e-9 || math.Abs(trends[2].Slope-0.5) > 1e-9 {
+		t.Errorf("3일 평균/기울기 이상: %+v", trends[2])
+	}
+	if trends[5].Anomaly != AnomalyHigh || trends[5].ZScore < 2 {
+		t.Errorf("40점 날은 high 이상치여야 함: %+v", trends[5])
+	}
+	// 직전 기간에 40점이 끼면 편차가 커져 평범한 날은 이상치가 아님
+	if trends[4].Anomaly != AnomalyNone || trends[6].Anomaly != AnomalyNone || trends[6].ZScore >= 0 {
+		t.Errorf("이상치 판정 이상: %+v / %+v", trends[4], trends[6])
+	}
+	if trends := Trends(data, TrendOptions{}); trends[5].Anomaly != AnomalyHigh || math.Abs(trends[6].Rolling-110.0/7) > 1e-9 {
+		t.Errorf("기본 7일 기준 이상: %+v", trends[6])
+	}
+
+	slopes := CategorySlopes(data, 3)
+	if math.Abs(slopes["업무"]-2) > 1e-9 || slopes["학습"] != 0 || len(slopes) != len(common.Categories) {
+		t.Errorf("CategorySlopes 이상: %v", slopes)
+	}
+}
+
 func TestRegression(t *testing.T) {
+	// 기울기와 절편이 다른 값이어야 순서가 바뀐 것을 잡아냄
 	data := []common.FocusData{
 		{Categories: map[string]int{"업무": 10}},
-		{Categories: map[string]int{"업무": 20}},
-		{Categories: map[string]int{"업무": 30}},
+		{Categories: map[string]int{"업무": 12}},
+		{Categories: map[string]int{"업무": 14}},
 	}
 	slope, intercept := Regression(data, "업무")
-	if slope < 9.9 || slope > 10.1 {
-		t.Errorf("slope = %f, want ~10", slope)
+	if math.Abs(slope-2) > 1e-9 {
+		t.Errorf("slope = %f, want 2", slope)
 	}
-	if intercept < 9.9 || intercept > 10.1 {
-		t.Errorf("intercept = %f, want ~10", intercept)
+	if math.Abs(intercept-10) > 1e-9 {
+		t.Errorf("intercept = %f, want 10", intercept)
+	}
+	if s := slopeOf([]float64{10, 12, 14}); math.Abs(s-slope) > 1e-9 {
+		t.Errorf("slopeOf = %f, Regression과 다름", s)
 	}
 }
 
@@ -67,15 +192,15 @@ func TestMakeEvalText(t *testing.T) {
 		{Categories: map[string]int{"업무": 10, "학습": 20, "취미": 0, "수면": 0, "이동": 0}},
 		{Categories: map[string]int{"업무": 20, "학습": 10, "취미": 0, "수면": 0, "이동": 0}},
 	}
-	eval := makeEvalText(data)
+	eval := makeEvalText(data, i18n.Default())
 	if len(eval) == 0 || eval == "" {
 		t.Errorf("makeEvalText 결과 없음")
 	}
 }
 
 func TestMakeWatermark(t *testing.T) {
-	wm := makeWatermark()
-	if len(wm) < 10 {
+	wm := makeWatermark(time.Date(2025, 5, 1, 15, 0, 0, 0, time.UTC))
+	if wm != "2025-05-02 00:00:00" {
 		t.Errorf("makeWatermark 결과 이상: %s", wm)
 	}
 }
@@ -85,7 +210,7 @@ func TestPlotFocusTrendsAndRegression(t *testing.T) {
 		{Date: "2024-06-01", Categories: map[string]int{"업무": 10, "학습": 20, "취미": 0, "수면": 0, "이동": 0}},
 		{Date: "2024-06-02", Categories: map[string]int{"업무": 20, "학습": 10, "취미": 0, "수면": 0, "이동": 0}},
 	}
-	imgBytes, err := PlotFocusTrendsAndRegression(data)
+	imgBytes, err := PlotFocusTrendsAndRegression(data, DefaultRenderOptions())
 	if err != nil {
 		t.Fatalf("PlotFocusTrendsAndRegression 실패: %v", err)
 	}
@@ -98,4 +223,473 @@ func TestPlotFocusTrendsAndRegression(t *testing.T) {
 	// 	t.Errorf("PNG 파일 저장 실패: %v", err)
 	// }
 }
- 
\ No newline at end of file
+ 
+func TestPlotFocusTrendsAndRegression_Formats(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2024-06-01", Categories: map[string]int{"업무": 10, "학습": 20}},
+		{Date: "2024-06-02", Categories: map[string]int{"업무": 20, "학습": 10}},
+	}
+	for _, f := range []Format{FormatPNG, FormatSVG, FormatPDF, FormatEPS} {
+		opts := DefaultRenderOptions()
+		opts.Format = f
+		b, err := PlotFocusTrendsAndRegression(data, opts)
+		if err != nil {
+			t.Fatalf("%s 렌더링 실패: %v", f, err)
+		}
+		if len(b) == 0 {
+			t.Errorf("%s 출력이 비어 있음", f)
+		}
+	}
+	if _, err := ParseFormat("gif"); err == nil {
+		t.Errorf("지원하지 않는 포맷인데 에러가 없음")
+	}
+}
+
+func TestPlotCalendarHeatmap(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-01-01", TotalFocus: 100, Categories: map[string]int{"업무": 50}, MaxScore: map[string]int{"업무": 100}},
+		{Date: "2025-01-05", TotalFocus: 300, Categories: map[string]int{"업무": 80}, MaxScore: map[string]int{"업무": 100}},
+		{Date: "2024-12-31", TotalFocus: 999},
+	}
+	for _, cat := range []string{"", "업무"} {
+		b, err := PlotCalendarHeatmap(data, CalendarOptions{Year: 2025, Category: cat}, DefaultRenderOptions())
+		if err != nil {
+			t.Fatalf("PlotCalendarHeatmap(%q) 실패: %v", cat, err)
+		}
+		if len(b) == 0 {
+			t.Errorf("달력 히트맵 출력이 비어 있음")
+		}
+	}
+	if _, err := PlotCalendarHeatmap(data, CalendarOptions{}, DefaultRenderOptions()); err == nil {
+		t.Errorf("연도 없이 호출했는데 에러가 없음")
+	}
+}
+
+func TestCalendarValue(t *testing.T) {
+	d := common.FocusData{TotalFocus: 70, Categories: map[string]int{"업무": 30}, MaxScore: map[string]int{"업무": 60}}
+	if v := calendarValue(d, ""); v != 70 {
+		t.Errorf("TotalFocus 값 = %v, want 70", v)
+	}
+	if v := calendarValue(d, "업무"); v != 50 {
+		t.Errorf("업무 효율 = %v, want 50", v)
+	}
+	if v := calendarValue(d, "학습"); v != 0 {
+		t.Errorf("기록 없는 카테고리 효율 = %v, want 0", v)
+	}
+}
+
+func TestPlotCategoryShare(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", MaxScore: map[string]int{"업무": 50, "수면": 200}},
+		{Date: "2025-05-02", MaxScore: map[string]int{"업무": 100, "수면": 150, "새카테고리": 10}},
+	}
+	for _, normalized := range []bool{false, true} {
+		b, err := PlotCategoryShare(data, normalized, DefaultRenderOptions())
+		if err != nil {
+			t.Fatalf("PlotCategoryShare(normalized=%v) 실패: %v", normalized, err)
+		}
+		if len(b) == 0 {
+			t.Errorf("누적 영역 그래프 출력이 비어 있음")
+		}
+	}
+	cats := orderedCategories(data)
+	if cats[0] != common.Categories[0] || cats[len(cats)-1] != "새카테고리" {
+		t.Errorf("카테고리 순서 이상: %v", cats)
+	}
+}
+
+func TestAnalyzeDaySlots(t *testing.T) {
+	slots := common.DaySlots{
+		Date:   "2025-05-01",
+		Labels: []string{"업무", "", "학습", "이동"},
+		Scores: []int{50, 90, 30, 40},
+	}
+	got := AnalyzeDaySlots(slots)
+	if got.Date != "2025-05-01" {
+		t.Errorf("Date = %s, want 2025-05-01", got.Date)
+	}
+	if got.TotalFocus != 80 {
+		t.Errorf("TotalFocus = %d, want 80 (빈 칸, 이동 제외)", got.TotalFocus)
+	}
+}
+
+func TestPlotDailyTimeline(t *testing.T) {
+	day := common.DaySlots{Date: "2025-05-01", Labels: make([]string, 144), Scores: make([]int, 144)}
+	for i := 54; i < 72; i++ {
+		day.Labels[i] = "업무"
+		day.Scores[i] = 4
+	}
+	week := []common.DaySlots{day, day, day}
+	for _, days := range [][]common.DaySlots{{day}, week} {
+		b, err := PlotDailyTimeline(days, DefaultRenderOptions())
+		if err != nil {
+			t.Fatalf("PlotDailyTimeline 실패: %v", err)
+		}
+		if len(b) == 0 {
+			t.Errorf("타임라인 출력이 비어 있음")
+		}
+	}
+	if _, err := PlotDailyTimeline(nil, DefaultRenderOptions()); err == nil {
+		t.Errorf("빈 입력인데 에러가 없음")
+	}
+}
+
+func TestAnalyzeFocus_KeepsSlotPositions(t *testing.T) {
+	labels := []string{"", "", "업무"}
+	scores := []int{0, 0, 70}
+	result := AnalyzeFocus(labels, scores)
+	if result.TimeSlots["00:20"] != 70 {
+		t.Errorf("00:20 = %d, want 70 (빈 칸도 자리를 차지해야 함)", result.TimeSlots["00:20"])
+	}
+	if result.SlotLabels["00:20"] != "업무" {
+		t.Errorf("SlotLabels[00:20] = %q, want 업무", result.SlotLabels["00:20"])
+	}
+	if v, ok := result.TimeSlots["00:00"]; !ok || v != 0 {
+		t.Errorf("빈 칸 시간대 = %d, %v (0점으로 기록돼야 함)", v, ok)
+	}
+	if _, ok := result.SlotLabels["00:00"]; ok {
+		t.Errorf("빈 칸 시간대가 SlotLabels에 기록됨")
+	}
+}
+
+func TestWeekdaySlotAverages(t *testing.T) {
+	// 2025-05-05, 2025-05-12 모두 월요일
+	data := []common.FocusData{
+		{Date: "2025-05-05", TimeSlots: map[string]int{"09:00": 40, "09:10": 60}, SlotLabels: map[string]string{"09:00": "업무", "09:10": "학습"}},
+		{Date: "2025-05-12", TimeSlots: map[string]int{"09:00": 80}, SlotLabels: map[string]string{"09:00": "업무"}},
+		{Date: "2025-05-13", TimeSlots: map[string]int{"09:00": 10}},
+	}
+	monday := 6 // weekdayRows에서 월요일 행
+	g := weekdaySlotAverages(data, WeekdayHeatmapOptions{Hourly: true})
+	if got := g.Z(9, monday); got != 60 {
+		t.Errorf("월요일 9시 평균 = %v, want 60", got)
+	}
+	g = weekdaySlotAverages(data, WeekdayHeatmapOptions{Category: "업무"})
+	if got := g.Z(54, monday); got != 60 {
+		t.Errorf("월요일 09:00 업무 평균 = %v, want 60", got)
+	}
+	if got := g.Z(55, monday); !math.IsNaN(got) {
+		t.Errorf("월요일 09:10 업무 평균 = %v, want NaN (학습 슬롯)", got)
+	}
+	b, err := PlotWeekdayHeatmap(data, WeekdayHeatmapOptions{Hourly: true}, DefaultRenderOptions())
+	if err != nil || len(b) == 0 {
+		t.Errorf("PlotWeekdayHeatmap 실패: %v", err)
+	}
+}
+
+func TestThemeCategoryColor(t *testing.T) {
+	theme := LightTheme()
+	rgb := common.CategoryColors["업무"]
+	r, g, b, _ := theme.CategoryColor("업무").RGBA()
+	if uint8(r>>8) != uint8(rgb[0]*255) || uint8(g>>8) != uint8(rgb[1]*255) || uint8(b>>8) != uint8(rgb[2]*255) {
+		t.Errorf("업무 색상이 CategoryColors와 다름")
+	}
+	custom := color.RGBA{R: 1, G: 2, B: 3, A: 255}
+	theme.CategoryColors = map[string]color.Color{"업무": custom}
+	if theme.CategoryColor("업무") != custom {
+		t.Errorf("테마 카테고리 색상이 적용되지 않음")
+	}
+	// 색상 정의가 없는 카테고리: 이름 길이가 같아도 이름 해시로 팔레트 위치가 갈림, 같은 이름은 항상 같은 색
+	if theme.CategoryColor("독서") == theme.CategoryColor("명상") {
+		t.Errorf("이름 길이가 같은 카테고리의 팔레트 위치가 같음")
+	}
+	if theme.CategoryColor("독서") != theme.SeriesColor(categoryPaletteIndex("독서")) || categoryPaletteIndex("독서") != categoryPaletteIndex("독서") {
+		t.Errorf("정의 없는 카테고리 색상이 팔레트 위치와 다름")
+	}
+	if _, err := ThemeByName("neon"); err == nil {
+		t.Errorf("알 수 없는 테마에 에러가 없음")
+	}
+}
+
+func TestPlotFocusTrendsAndRegression_DarkTheme(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", Categories: map[string]int{"업무": 50}, MaxScore: map[string]int{"업무": 100}, TotalFocus: 50},
+		{Date: "2025-05-02", Categories: map[string]int{"업무": 80}, MaxScore: map[string]int{"업무": 100}, TotalFocus: 80},
+	}
+	dark, err := ThemeByName("dark")
+	if err != nil {
+		t.Fatalf("ThemeByName(dark) 실패: %v", err)
+	}
+	opts := DefaultRenderOptions()
+	opts.Format = FormatSVG
+	opts.Theme = &dark
+	b, err := PlotFocusTrendsAndRegression(data, opts)
+	if err != nil {
+		t.Fatalf("PlotFocusTrendsAndRegression 실패: %v", err)
+	}
+	if !strings.Contains(string(b), "fill:#1E1E24") {
+		t.Errorf("다크 테마 배경색이 SVG에 없음")
+	}
+}
+
+func TestPlotFocusTrendsAndRegression_English(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", Categories: map[string]int{"업무": 50}, MaxScore: map[string]int{"업무": 100}, TotalFocus: 50},
+		{Date: "2025-05-02", Categories: map[string]int{"업무": 80}, MaxScore: map[string]int{"업무": 100}, TotalFocus: 80},
+	}
+	opts := goldenRenderOptions(t)
+	opts.Locale = i18n.English
+	b, err := PlotFocusTrendsAndRegression(data, opts)
+	if err != nil {
+		t.Fatalf("PlotFocusTrendsAndRegression 실패: %v", err)
+	}
+	svg := string(b)
+	for _, want := range []string{"Category trends and regression", "Work (actual)", "Work (trend)", "(today)"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("영어 SVG에 %q 없음", want)
+		}
+	}
+	for _, unwanted := range []string{"업무", "회귀선", "오늘"} {
+		if strings.Contains(svg, unwanted) {
+			t.Errorf("영어 SVG에 한국어 %q 남아 있음", unwanted)
+		}
+	}
+}
+
+var update = flag.Bool("update", false, "testdata/golden 파일 갱신")
+
+// goldenRenderOptions: 실행 환경과 무관하게 같은 SVG가 나오도록 고정한 옵션
+// (기준 시각 고정, 워터마크 없음, 폰트는 내장 폰트)
+func goldenRenderOptions(t *testing.T) RenderOptions {
+	t.Helper()
+	opts := DefaultRenderOptions()
+	opts.Format = FormatSVG
+	opts.Width, opts.Height = vg.Points(640), vg.Points(320)
+	opts.Now = time.Date(2025, 5, 7, 9, 0, 0, 0, time.UTC)
+	opts.NoWatermark = true
+	return opts
+}
+
+// assertGolden: 렌더 결과를 testdata/golden/<name>과 바이트 단위로 비교 (-update면 갱신)
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("golden 디렉토리 생성 실패: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("golden 파일 저장 실패: %v", err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden 파일 읽기 실패 (go test -run %s -update 로 생성): %v", t.Name(), err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s 렌더 결과가 golden 파일과 다름 (의도한 변경이면 -update)", name)
+	}
+}
+
+// goldenData: golden 테스트용 고정 데이터 (2025-05-01 ~ 2025-05-07)
+func goldenData() []common.FocusData {
+	data := []common.FocusData{}
+	for i := 0; i < 7; i++ {
+		labels := make([]string, 144)
+		scores := make([]int, 144)
+		for s := 54; s < 126; s++ {
+			labels[s] = common.Categories[(s/12+i)%len(common.Categories)]
+			scores[s] = (s*7 + i*13) % 101
+		}
+		d := AnalyzeFocus(labels, scores)
+		d.Date = fmt.Sprintf("2025-05-%02d", i+1)
+		data = append(data, d)
+	}
+	return data
+}
+
+func TestGolden_FocusTrends(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	b, err := PlotFocusTrendsAndRegression(goldenData(), opts)
+	if err != nil {
+		t.Fatalf("PlotFocusTrendsAndRegression 실패: %v", err)
+	}
+	assertGolden(t, "focus-trends.svg", b)
+}
+
+func TestGolden_TimeSlotAverage(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	b, err := PlotTimeSlotAverageFocus(goldenData(), opts)
+	if err != nil {
+		t.Fatalf("PlotTimeSlotAverageFocus 실패: %v", err)
+	}
+	assertGolden(t, "timeslot-average.svg", b)
+}
+
+func TestGolden_CalendarHeatmap(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	b, err := PlotCalendarHeatmap(goldenData(), CalendarOptions{Year: 2025}, opts)
+	if err != nil {
+		t.Fatalf("PlotCalendarHeatmap 실패: %v", err)
+	}
+	assertGolden(t, "calendar.svg", b)
+}
+
+func TestGolden_CategoryShare(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	b, err := PlotCategoryShare(goldenData(), true, opts)
+	if err != nil {
+		t.Fatalf("PlotCategoryShare 실패: %v", err)
+	}
+	assertGolden(t, "category-share.svg", b)
+}
+
+func TestGolden_WeekdayHeatmap(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	b, err := PlotWeekdayHeatmap(goldenData(), WeekdayHeatmapOptions{Hourly: true}, opts)
+	if err != nil {
+		t.Fatalf("PlotWeekdayHeatmap 실패: %v", err)
+	}
+	assertGolden(t, "weekday-heatmap.svg", b)
+}
+
+func TestBlockHeight(t *testing.T) {
+	if blockHeight(common.MaxSlotScore) <= blockHeight(1) {
+		t.Errorf("5점 칸 높이 %v가 1점 칸 높이 %v보다 높아야 함", blockHeight(common.MaxSlotScore), blockHeight(1))
+	}
+	if got := blockHeight(common.MaxSlotScore); got != 0.8 {
+		t.Errorf("최대 점수 칸 높이 = %v, want 0.8 (행 높이 전체)", got)
+	}
+	if blockHeight(0) <= 0 {
+		t.Errorf("0점 칸도 보여야 함")
+	}
+}
+
+func TestGolden_DailyTimeline(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	labels := make([]string, 144)
+	scores := make([]int, 144)
+	for s := 54; s < 126; s++ {
+		labels[s] = common.Categories[(s/12)%len(common.Categories)]
+		scores[s] = (s * 7) % (common.MaxSlotScore + 1)
+	}
+	b, err := PlotDailyTimeline([]common.DaySlots{{Date: "2025-05-07", Labels: labels, Scores: scores}}, opts)
+	if err != nil {
+		t.Fatalf("PlotDailyTimeline 실패: %v", err)
+	}
+	assertGolden(t, "daily-timeline.svg", b)
+}
+
+func TestWeekdaySlotAverages_SlotMinutes(t *testing.T) {
+	// 2025-05-05 월요일, 30분 슬롯 한 칸은 10분 열 세 칸에 걸침
+	data := []common.FocusData{{Date: "2025-05-05", SlotMinutes: 30, TimeSlots: map[string]int{"09:00": 4}}}
+	g := weekdaySlotAverages(data, WeekdayHeatmapOptions{})
+	monday := 6
+	for c := 54; c < 57; c++ {
+		if got := g.Z(c, monday); got != 4 {
+			t.Errorf("열 %d = %v, want 4", c, got)
+		}
+	}
+	if got := g.Z(57, monday); !math.IsNaN(got) {
+		t.Errorf("09:30 열 = %v, want NaN", got)
+	}
+}
+
+func TestBoxPlots(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", Categories: map[string]int{"업무": 50, "수면": 0}, MaxScore: map[string]int{"업무": 100, "수면": 0}, TimeSlots: map[string]int{"09:00": 4, "09:10": 0}},
+		{Date: "2025-05-02", Categories: map[string]int{"업무": 30}, MaxScore: map[string]int{"업무": 60}, TimeSlots: map[string]int{"09:30": 2, "23:50": 5}},
+	}
+	if vals := categoryEfficiencies(data, "업무"); len(vals) != 2 || vals[0] != 50 || vals[1] != 50 {
+		t.Errorf("업무 효율 = %v, want [50 50]", vals)
+	}
+	if vals := categoryEfficiencies(data, "수면"); len(vals) != 0 {
+		t.Errorf("MaxScore 0인 날은 제외되어야 함: %v", vals)
+	}
+	hours := hourlySlotScores(data)
+	if len(hours[9]) != 2 || len(hours[23]) != 1 {
+		t.Errorf("시간별 슬롯 점수 이상: 9시=%v, 23시=%v", hours[9], hours[23])
+	}
+	if b, err := PlotCategoryBoxPlot(data, DefaultRenderOptions()); err != nil || len(b) == 0 {
+		t.Errorf("PlotCategoryBoxPlot 실패: %v", err)
+	}
+	if b, err := PlotTimeSlotBoxPlot(data, DefaultRenderOptions()); err != nil || len(b) == 0 {
+		t.Errorf("PlotTimeSlotBoxPlot 실패: %v", err)
+	}
+	if _, err := PlotTimeSlotBoxPlot([]common.FocusData{{Date: "2025-05-03"}}, DefaultRenderOptions()); err == nil {
+		t.Errorf("기록 없는 데이터에 에러가 없음")
+	}
+}
+
+func TestInitKoreanFont(t *testing.T) {
+	if err := InitKoreanFont(); err != nil {
+		t.Fatalf("내장 폰트 등록 실패: %v", err)
+	}
+	if plot.DefaultFont.Typeface != "KoreanFont" {
+		t.Errorf("기본 폰트가 한글 폰트가 아님: %v", plot.DefaultFont.Typeface)
+	}
+	if err := registerKoreanFont(filepath.Join(t.TempDir(), "missing.ttf")); err == nil {
+		t.Errorf("없는 폰트 파일에 에러가 없음")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", TotalFocus: 30, Categories: map[string]int{"업무": 10, "학습": 18}, MaxScore: map[string]int{"업무": 20, "학습": 20}, TimeSlots: map[string]int{"09:00": 5, "09:10": 4, "09:20": 3}, SlotLabels: map[string]string{"09:00": "업무", "09:10": "학습", "09:20": "학습"}},
+		{Date: "2025-05-02", TotalFocus: 20, Categories: map[string]int{"업무": 20}, MaxScore: map[string]int{"업무": 20}, TimeSlots: map[string]int{"10:00": 4}, SlotLabels: map[string]string{"10:00": "업무"}},
+	}
+	s := Summarize(data)
+	if s.From != "2025-05-01" || s.To != "2025-05-02" || s.Days != 2 || s.TotalFocus != 50 {
+		t.Errorf("기간/합계 이상: %+v", s)
+	}
+	if s.BestCategory != "학습" || s.BestEfficiency != 90 {
+		t.Errorf("최고 카테고리 = %s(%.0f), want 학습(90)", s.BestCategory, s.BestEfficiency)
+	}
+	if s.DeepWorkHours != 0.5 {
+		t.Errorf("딥워크 시간 = %v, want 0.5", s.DeepWorkHours)
+	}
+}
+
+func TestSummarize_SleepHeavyDay(t *testing.T) {
+	// 8시간 수면(5점) + 1시간 이동(5점) + 업무 30분(4점)
+	d := common.FocusData{Date: "2025-05-03", TimeSlots: map[string]int{}, SlotLabels: map[string]string{}}
+	add := func(from, n int, label string, score int) {
+		for i := from; i < from+n; i++ {
+			key := common.DefaultSlotLayout.Key(i)
+			d.TimeSlots[key], d.SlotLabels[key] = score, label
+		}
+	}
+	add(0, 48, "수면", 5)
+	add(48, 6, "이동", 5)
+	add(54, 3, "업무", 4)
+	if s := Summarize([]common.FocusData{d}); s.DeepWorkHours != 0.5 {
+		t.Errorf("딥워크 시간 = %v, want 0.5 (수면/이동 제외)", s.DeepWorkHours)
+	}
+	// 슬롯 길이는 날마다 기록된 값 기준 (30분 슬롯 1칸 = 0.5시간)
+	long := common.FocusData{Date: "2025-05-04", SlotMinutes: 30, TimeSlots: map[string]int{"09:00": 5}, SlotLabels: map[string]string{"09:00": "업무"}}
+	if s := Summarize([]common.FocusData{d, long}); s.DeepWorkHours != 1 {
+		t.Errorf("10분/30분 혼합 딥워크 시간 = %v, want 1", s.DeepWorkHours)
+	}
+	// 카테고리 기록이 없는 이전 JSON은 딥워크를 셀 수 없음
+	d.SlotLabels = nil
+	if s := Summarize([]common.FocusData{d}); s.DeepWorkHours != 0 {
+		t.Errorf("SlotLabels 없는 기록의 딥워크 시간 = %v, want 0", s.DeepWorkHours)
+	}
+}
+
+func TestParsePanels(t *testing.T) {
+	panels, err := ParsePanels(" trends, Heatmap ,,category-box")
+	if err != nil || len(panels) != 3 || panels[1] != PanelHeatmap {
+		t.Errorf("ParsePanels 결과 이상: %v, %v", panels, err)
+	}
+	if _, err := ParsePanels("trends,pie"); err == nil {
+		t.Errorf("알 수 없는 패널에 에러가 없음")
+	}
+	if panels, _ := ParsePanels(""); len(panels) != len(DefaultDashboardOptions().Panels) {
+		t.Errorf("빈 문자열은 기본 패널이어야 함: %v", panels)
+	}
+	if rows := (DashboardOptions{Panels: panels, Columns: 2}).Rows(); rows != 2 {
+		t.Errorf("Rows = %d, want 2", rows)
+	}
+}
+
+func TestGolden_Dashboard(t *testing.T) {
+	opts := goldenRenderOptions(t)
+	opts.Height = vg.Points(480)
+	dash := DashboardOptions{Panels: []Panel{PanelTimeSlot, PanelShare, PanelCategoryBox}, Columns: 2}
+	b, err := PlotDashboard(goldenData(), dash, opts)
+	if err != nil {
+		t.Fatalf("PlotDashboard 실패: %v", err)
+	}
+	assertGolden(t, "dashboard.svg", b)
+}
@@ -0,0 +1,234 @@
+package analyzer
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// CalendarOptions: 달력 히트맵 옵션
+// - Year: 그릴 연도
+// - Category: 비어 있으면 TotalFocus, 지정하면 해당 카테고리 효율(%)
+// - Colors: 낮은 값→높은 값 순의 색상 스케일 (비면 기본 초록 계열)
+// - Min, Max: 색상 스케일 범위 (둘 다 0이면 데이터에서 자동 계산)
+// - MissingColor: 기록이 없는 날 색상 (nil이면 테마의 Missing)
+type CalendarOptions struct {
+	Year         int
+	Category     string
+	Colors       []color.Color
+	Min, Max     float64
+	MissingColor color.Color
+}
+
+// DefaultCalendarColors: GitHub contribution 그래프와 비슷한 초록 계열 스케일
+var DefaultCalendarColors = []color.Color{
+	color.RGBA{R: 0xeb, G: 0xed, B: 0xf0, A: 0xff},
+	color.RGBA{R: 0x9b, G: 0xe9, B: 0xa8, A: 0xff},
+	color.RGBA{R: 0x40, G: 0xc4, B: 0x63, A: 0xff},
+	color.RGBA{R: 0x30, G: 0xa1, B: 0x4e, A: 0xff},
+	color.RGBA{R: 0x21, G: 0x6e, B: 0x39, A: 0xff},
+}
+
+// calendarValue: 달력 칸에 표시할 값 (TotalFocus 또는 카테고리 효율)
+func calendarValue(d common.FocusData, category string) float64 {
+	if category == "" {
+		return float64(d.TotalFocus)
+	}
+	max := d.MaxScore[category]
+	if max <= 0 {
+		return 0
+	}
+	return float64(d.Categories[category]) / float64(max) * 100.0
+}
+
+// calendarCell: 달력 한 칸 (열=주, 행=요일)
+type calendarCell struct {
+	week, weekday int
+	value         float64
+	state         int // cellValue, cellMissing, cellEmpty
+}
+
+const (
+	cellValue   = iota
+	cellMissing // 추적 기간 중 기록이 없는 날
+	cellEmpty   // 추적 시작 전 또는 마지막 기록 이후
+)
+
+// calendarCells: 달력 칸들을 그리는 plot.Plotter
+type calendarCells struct {
+	cells    []calendarCell
+	weeks    int
+	min, max float64
+	colors   []color.Color
+	missing  color.Color
+	theme    Theme
+}
+
+// Plot: plot.Plotter 구현 (칸 채우기 + 결측일 X 표시)
+func (c *calendarCells) Plot(dc draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&dc)
+	border := draw.LineStyle{Color: c.theme.Background, Width: vg.Points(1)}
+	mark := draw.LineStyle{Color: shade(c.missing, 0.35), Width: vg.Points(0.8)}
+	for _, cell := range c.cells {
+		x0, x1 := trX(float64(cell.week)-0.5), trX(float64(cell.week)+0.5)
+		y := float64(6 - cell.weekday) // 일요일이 맨 위
+		y0, y1 := trY(y-0.5), trY(y+0.5)
+		rect := []vg.Point{{X: x0, Y: y0}, {X: x1, Y: y0}, {X: x1, Y: y1}, {X: x0, Y: y1}}
+		switch cell.state {
+		case cellValue:
+			dc.FillPolygon(scaleColor(c.colors, c.min, c.max, cell.value), rect)
+		case cellMissing:
+			dc.FillPolygon(c.missing, rect)
+			inset := (x1 - x0) / 4
+			dc.StrokeLine2(mark, x0+inset, y0+inset, x1-inset, y1-inset)
+			dc.StrokeLine2(mark, x0+inset, y1-inset, x1-inset, y0+inset)
+		case cellEmpty:
+			dc.FillPolygon(c.theme.Empty, rect)
+		}
+		dc.StrokeLines(border, append(rect, rect[0]))
+	}
+}
+
+// DataRange: plot.DataRanger 구현
+func (c *calendarCells) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return -0.5, float64(c.weeks) - 0.5, -0.5, 6.5
+}
+
+// scaleColor: [min, max] 범위의 v를 색상 스케일로 선형 보간
+func scaleColor(colors []color.Color, min, max, v float64) color.Color {
+	if len(colors) == 0 {
+		return color.Black
+	}
+	if len(colors) == 1 || max <= min {
+		return colors[len(colors)-1]
+	}
+	t := (v - min) / (max - min)
+	t = math.Max(0, math.Min(1, t))
+	pos := t * float64(len(colors)-1)
+	i := int(pos)
+	if i >= len(colors)-1 {
+		return colors[len(colors)-1]
+	}
+	frac := pos - float64(i)
+	r0, g0, b0, a0 := colors[i].RGBA()
+	r1, g1, b1, a1 := colors[i+1].RGBA()
+	lerp := func(a, b uint32) uint8 {
+		return uint8((float64(a) + (float64(b)-float64(a))*frac) / 257)
+	}
+	return color.RGBA{R: lerp(r0, r1), G: lerp(g0, g1), B: lerp(b0, b1), A: lerp(a0, a1)}
+}
+
+// PlotCalendarHeatmap: 연간 달력 히트맵(주=열, 요일=행)을 opts 포맷으로 렌더링
+// - data: 저장된 일별 FocusData 배열 (다른 연도 데이터는 무시)
+// - cal: 달력 옵션 (연도, 카테고리, 색상 스케일, 결측 색상)
+// - opts: 출력 포맷/크기/DPI
+// 반환: 이미지 []byte, 에러
+func PlotCalendarHeatmap(data []common.FocusData, cal CalendarOptions, opts RenderOptions) ([]byte, error) {
+	p, err := calendarHeatmapPlot(data, cal, opts.theme(), opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// calendarHeatmapPlot: 달력 히트맵 plot 구성
+func calendarHeatmapPlot(data []common.FocusData, cal CalendarOptions, theme Theme, tr i18n.Printer) (*plot.Plot, error) {
+	if cal.Year == 0 {
+		return nil, fmt.Errorf("달력 히트맵 연도가 지정되지 않았습니다")
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+	colors := cal.Colors
+	if len(colors) == 0 {
+		colors = DefaultCalendarColors
+	}
+	missing := cal.MissingColor
+	if missing == nil {
+		missing = theme.Missing
+	}
+
+	// 해당 연도의 날짜별 값 수집
+	byDate := map[string]float64{}
+	firstDate, lastDate := "", ""
+	for _, d := range data {
+		t, err := time.Parse("2006-01-02", d.Date)
+		if err != nil || t.Year() != cal.Year {
+			continue
+		}
+		byDate[d.Date] = calendarValue(d, cal.Category)
+		if firstDate == "" || d.Date < firstDate {
+			firstDate = d.Date
+		}
+		if d.Date > lastDate {
+			lastDate = d.Date
+		}
+	}
+	min, max := cal.Min, cal.Max
+	if min == 0 && max == 0 {
+		first := true
+		for _, v := range byDate {
+			if first || v < min {
+				min = v
+			}
+			if first || v > max {
+				max = v
+			}
+			first = false
+		}
+	}
+
+	// 1월 1일이 속한 주를 0열로 두고 일요일 시작 주 단위로 배치
+	jan1 := time.Date(cal.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	offset := int(jan1.Weekday())
+	cells := &calendarCells{min: min, max: max, colors: colors, missing: missing, theme: theme}
+	monthTicks := []plot.Tick{}
+	for d := jan1; d.Year() == cal.Year; d = d.AddDate(0, 0, 1) {
+		week := (d.YearDay() - 1 + offset) / 7
+		dateStr := d.Format("2006-01-02")
+		cell := calendarCell{week: week, weekday: int(d.Weekday())}
+		if v, ok := byDate[dateStr]; ok {
+			cell.value = v
+			cell.state = cellValue
+		} else if dateStr > firstDate && dateStr < lastDate {
+			cell.state = cellMissing
+		} else {
+			cell.state = cellEmpty
+		}
+		cells.cells = append(cells.cells, cell)
+		if week+1 > cells.weeks {
+			cells.weeks = week + 1
+		}
+		if d.Day() == 1 {
+			monthTicks = append(monthTicks, plot.Tick{Value: float64(week), Label: tr.Month(d.Month())})
+		}
+	}
+
+	p := theme.newPlot()
+	p.Title.Text = tr.T("calendar.total", cal.Year)
+	if cal.Category != "" {
+		p.Title.Text = tr.T("calendar.cat", cal.Year, tr.Category(cal.Category))
+	}
+	p.Title.Padding = vg.Points(10)
+	p.Add(cells)
+	p.X.Tick.Marker = plot.ConstantTicks(monthTicks)
+	p.Y.Tick.Marker = plot.ConstantTicks([]plot.Tick{
+		{Value: 5, Label: tr.Weekday(time.Monday)}, {Value: 3, Label: tr.Weekday(time.Wednesday)}, {Value: 1, Label: tr.Weekday(time.Friday)},
+	})
+	p.X.LineStyle.Width = 0
+	p.Y.LineStyle.Width = 0
+	p.X.Tick.LineStyle.Width = 0
+	p.Y.Tick.LineStyle.Width = 0
+
+	// 칸이 그림 전체를 채우므로 범례 대신 축 라벨로 색상 범위/결측 표시 설명
+	p.X.Label.Text = tr.T("calendar.legend", min, max)
+	p.X.Label.TextStyle.Font.Size = vg.Points(9)
+	return p, nil
+}
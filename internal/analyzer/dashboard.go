@@ -0,0 +1,241 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// DeepWorkMinScore: 딥워크로 보는 최소 슬롯 점수 (시트 점수 0~5 기준)
+const DeepWorkMinScore = 4
+
+// Panel: 대시보드에 넣을 그래프 종류
+type Panel string
+
+const (
+	PanelTrends      Panel = "trends"       // 카테고리별 트렌드 및 회귀선
+	PanelTimeSlot    Panel = "timeslot"     // 시간대별 평균 몰입 점수 (전체 평균)
+	PanelShare       Panel = "share"        // 카테고리 기록 비율 (100% 누적)
+	PanelHeatmap     Panel = "heatmap"      // 요일×시간대 히트맵 (1시간 단위)
+	PanelCalendar    Panel = "calendar"     // 기간 마지막 날짜 연도의 달력 히트맵
+	PanelCategoryBox Panel = "category-box" // 카테고리별 효율 분포
+	PanelTimeSlotBox Panel = "timeslot-box" // 시간대별 슬롯 점수 분포
+)
+
+// DashboardOptions: 대시보드 구성
+// - Panels: 표시할 패널 (순서대로 왼쪽→오른쪽, 위→아래)
+// - Columns: 한 줄에 놓을 패널 수
+type DashboardOptions struct {
+	Panels  []Panel
+	Columns int
+}
+
+// DefaultDashboardOptions: 트렌드, 시간대, 카테고리 비율, 요일 히트맵 2×2
+func DefaultDashboardOptions() DashboardOptions {
+	return DashboardOptions{
+		Panels:  []Panel{PanelTrends, PanelTimeSlot, PanelShare, PanelHeatmap},
+		Columns: 2,
+	}
+}
+
+// ParsePanels: 쉼표로 구분한 패널 이름 → []Panel (빈 문자열이면 기본 패널)
+// 반환: 패널 목록, 에러 (알 수 없는 패널)
+func ParsePanels(s string) ([]Panel, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultDashboardOptions().Panels, nil
+	}
+	panels := []Panel{}
+	for _, name := range strings.Split(s, ",") {
+		p := Panel(strings.ToLower(strings.TrimSpace(name)))
+		if p == "" {
+			continue
+		}
+		if _, ok := panelBuilders[p]; !ok {
+			return nil, fmt.Errorf("알 수 없는 대시보드 패널: %q", name)
+		}
+		panels = append(panels, p)
+	}
+	return panels, nil
+}
+
+// Rows: 패널 수와 열 수로 계산한 줄 수
+func (o DashboardOptions) Rows() int {
+	cols := o.columns()
+	return (len(o.Panels) + cols - 1) / cols
+}
+
+// columns: 열 수 (0 이하이면 2, 패널 수보다 많으면 패널 수)
+func (o DashboardOptions) columns() int {
+	cols := o.Columns
+	if cols <= 0 {
+		cols = 2
+	}
+	if len(o.Panels) > 0 && cols > len(o.Panels) {
+		cols = len(o.Panels)
+	}
+	return cols
+}
+
+// panelBuilders: 패널별 plot 구성 함수 (워터마크는 헤더에만 표시)
+var panelBuilders = map[Panel]func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error){
+	PanelTrends: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		opts.NoWatermark = true
+		return focusTrendsFromData(data, opts)
+	},
+	PanelTimeSlot: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		return timeSlotAverageFocusPlot([]common.FocusData{aggregateTimeSlots(data)}, opts.theme(), opts.printer(), "")
+	},
+	PanelShare: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		return categorySharePlot(data, true, opts.theme(), opts.printer())
+	},
+	PanelHeatmap: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		return weekdayHeatmapPlot(data, WeekdayHeatmapOptions{Hourly: true}, opts.theme(), opts.printer())
+	},
+	PanelCalendar: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		// 기간 마지막 날짜의 연도 (날짜 형식이 아니면 기준 시각의 연도)
+		year := opts.now().Year()
+		if t, err := time.Parse("2006-01-02", data[len(data)-1].Date); err == nil {
+			year = t.Year()
+		}
+		return calendarHeatmapPlot(data, CalendarOptions{Year: year}, opts.theme(), opts.printer())
+	},
+	PanelCategoryBox: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		return categoryBoxPlot(data, opts.theme(), opts.printer())
+	},
+	PanelTimeSlotBox: func(data []common.FocusData, opts RenderOptions) (*plot.Plot, error) {
+		return timeSlotBoxPlot(data, opts.theme(), opts.printer())
+	},
+}
+
+// Summary: 기간 요약 통계 (대시보드 헤더 등)
+// - BestCategory: 평균 효율(%)이 가장 높은 카테고리 (기록 없으면 빈 문자열)
+// - DeepWorkHours: 딥워크 카테고리(common.IsDeepWork) 슬롯 중 점수가 DeepWorkMinScore 이상인 슬롯의 총 시간 (SlotLabels 없는 이전 기록은 0)
+type Summary struct {
+	From, To       string
+	Days           int
+	TotalFocus     int
+	BestCategory   string
+	BestEfficiency float64
+	DeepWorkHours  float64
+}
+
+// Summarize: FocusData 배열의 기간 요약 통계 계산
+// - data: 일자 오름차순 FocusData 배열
+func Summarize(data []common.FocusData) Summary {
+	s := Summary{Days: len(data)}
+	if len(data) == 0 {
+		return s
+	}
+	s.From, s.To = data[0].Date, data[len(data)-1].Date
+	deepMinutes := 0
+	for _, d := range data {
+		s.TotalFocus += d.TotalFocus
+		for key, label := range d.SlotLabels {
+			if common.IsDeepWork(label) && d.TimeSlots[key] >= DeepWorkMinScore {
+				deepMinutes += common.SlotMinutesOf(d) // 날마다 기록된 슬롯 길이 기준
+			}
+		}
+	}
+	s.DeepWorkHours = float64(deepMinutes) / 60.0
+	for _, cat := range orderedCategories(data) {
+		vals := categoryEfficiencies(data, cat)
+		if len(vals) == 0 {
+			continue
+		}
+		sum := 0.0
+		for _, v := range vals {
+			sum += v
+		}
+		if avg := sum / float64(len(vals)); s.BestCategory == "" || avg > s.BestEfficiency {
+			s.BestCategory, s.BestEfficiency = cat, avg
+		}
+	}
+	return s
+}
+
+// PlotDashboard: 여러 패널을 한 캔버스에 타일로 배치한 대시보드 이미지 렌더링
+// - data: 일자 오름차순 FocusData 배열 (모든 패널이 같은 기간 사용)
+// - dash: 패널 종류/배치
+// - opts: 출력 포맷/크기/DPI, 테마, 기준 시각/워터마크 (크기는 대시보드 전체 크기)
+// 반환: 이미지 []byte, 에러
+func PlotDashboard(data []common.FocusData, dash DashboardOptions, opts RenderOptions) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("분석할 데이터가 없습니다")
+	}
+	if len(dash.Panels) == 0 {
+		dash.Panels = DefaultDashboardOptions().Panels
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+	theme := opts.theme()
+
+	cols, rows := dash.columns(), dash.Rows()
+	plots := make([][]*plot.Plot, rows)
+	for r := range plots {
+		plots[r] = make([]*plot.Plot, cols)
+	}
+	for i, panel := range dash.Panels {
+		build, ok := panelBuilders[panel]
+		if !ok {
+			return nil, fmt.Errorf("알 수 없는 대시보드 패널: %q", panel)
+		}
+		p, err := build(data, opts)
+		if err != nil {
+			return nil, fmt.Errorf("대시보드 패널 %s 생성 실패: %w", panel, err)
+		}
+		plots[i/cols][i%cols] = p
+	}
+
+	header := dashboardHeader(Summarize(data), opts.watermark(), opts.printer())
+	return renderCanvas(opts, func(dc draw.Canvas) {
+		dc.SetColor(theme.Background)
+		dc.Fill(dc.Rectangle.Path())
+
+		title := draw.TextStyle{Color: theme.Foreground, Font: plot.DefaultFont, Handler: plot.DefaultTextHandler, XAlign: draw.XLeft, YAlign: draw.YTop}
+		title.Font.Size = theme.TitleSize * 1.5
+		stats := title
+		stats.Font.Size = theme.LabelSize
+		left := dc.Min.X + vg.Points(16)
+		top := dc.Max.Y - vg.Points(10)
+		dc.FillText(title, vg.Point{X: left, Y: top}, header[0])
+		dc.FillText(stats, vg.Point{X: left, Y: top - title.Font.Size*1.6}, header[1])
+
+		// 헤더 아래 영역에 패널 타일 배치
+		body := dc
+		body.Max.Y = top - title.Font.Size*1.6 - stats.Font.Size*1.6 - vg.Points(10)
+		tiles := draw.Tiles{
+			Rows: rows, Cols: cols,
+			PadX: vg.Points(20), PadY: vg.Points(20),
+			PadTop: vg.Points(10), PadBottom: vg.Points(10), PadLeft: vg.Points(10), PadRight: vg.Points(10),
+		}
+		canvases := plot.Align(plots, tiles, body)
+		for r := range plots {
+			for c, p := range plots[r] {
+				if p != nil {
+					p.Draw(canvases[r][c])
+				}
+			}
+		}
+	})
+}
+
+// dashboardHeader: 헤더 제목/통계 두 줄 텍스트
+func dashboardHeader(s Summary, watermark string, tr i18n.Printer) [2]string {
+	title := tr.T("dash.title", tr.DateRange(s.From, s.To), s.Days)
+	best := "-"
+	if s.BestCategory != "" {
+		best = tr.T("dash.best", tr.Category(s.BestCategory), s.BestEfficiency)
+	}
+	stats := tr.T("dash.stats", s.TotalFocus, best, s.DeepWorkHours, DeepWorkMinScore)
+	if watermark != "" {
+		stats += tr.T("dash.generated", watermark)
+	}
+	return [2]string{title, stats}
+}
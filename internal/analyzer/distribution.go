@@ -0,0 +1,167 @@
+package analyzer
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// categoryEfficiencies: 카테고리별 일자 효율(%) 값 목록 (Categories / MaxScore * 100, 기록 없는 날 제외)
+func categoryEfficiencies(data []common.FocusData, cat string) plotter.Values {
+	vals := plotter.Values{}
+	for _, d := range data {
+		max := d.MaxScore[cat]
+		if max <= 0 {
+			continue
+		}
+		vals = append(vals, float64(d.Categories[cat])/float64(max)*100.0)
+	}
+	return vals
+}
+
+// hourlySlotScores: 시간(0~23)별 슬롯 점수 목록 (0점 제외, 시간대 평균 그래프와 동일 기준)
+func hourlySlotScores(data []common.FocusData) [24]plotter.Values {
+	var out [24]plotter.Values
+	for _, d := range data {
+		for key, v := range d.TimeSlots {
+			if v == 0 {
+				continue
+			}
+			var h, m int
+			if _, err := fmt.Sscanf(key, "%02d:%02d", &h, &m); err != nil || h < 0 || h > 23 {
+				continue
+			}
+			out[h] = append(out[h], float64(v))
+		}
+	}
+	return out
+}
+
+// styleBox: 박스 플롯에 테마 색상 적용 (채우기 fill, 선/이상치는 전경색)
+func styleBox(b *plotter.BoxPlot, theme Theme, fill color.Color) {
+	b.FillColor = fill
+	line := draw.LineStyle{Color: theme.Foreground, Width: vg.Points(1)}
+	b.BoxStyle = line
+	b.WhiskerStyle = line
+	b.MedianStyle = draw.LineStyle{Color: theme.Foreground, Width: theme.LineWidth}
+	b.GlyphStyle.Color = theme.Foreground
+}
+
+// PlotCategoryBoxPlot: 카테고리별 일자 효율(%) 분포 박스 플롯을 opts 포맷으로 렌더링
+// - data: 여러 일자의 FocusData 배열 (기간은 호출 측에서 선택)
+// - opts: 출력 포맷/크기/DPI, 테마
+// 반환: 이미지 []byte, 에러
+func PlotCategoryBoxPlot(data []common.FocusData, opts RenderOptions) ([]byte, error) {
+	p, err := categoryBoxPlot(data, opts.theme(), opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// categoryBoxPlot: 카테고리 효율 박스 플롯 plot 구성
+func categoryBoxPlot(data []common.FocusData, theme Theme, tr i18n.Printer) (*plot.Plot, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("분석할 데이터가 없습니다")
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+
+	p := theme.newPlot()
+	p.Title.Text = tr.T("box.cat.title", tr.DateRange(data[0].Date, data[len(data)-1].Date))
+	p.Title.Padding = vg.Points(10)
+	p.Y.Label.Text = tr.T("axis.efficiency")
+	p.Y.Label.Padding = vg.Points(10)
+
+	names := []string{}
+	for _, cat := range orderedCategories(data) {
+		vals := categoryEfficiencies(data, cat)
+		if len(vals) == 0 {
+			continue
+		}
+		b, err := plotter.NewBoxPlot(vg.Points(30), float64(len(names)), vals)
+		if err != nil {
+			return nil, err
+		}
+		styleBox(b, theme, theme.CategoryColor(cat))
+		p.Add(b)
+		names = append(names, tr.T("box.cat.label", tr.Category(cat), len(vals)))
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("효율을 계산할 카테고리 기록이 없습니다")
+	}
+	p.NominalX(names...)
+	p.X.Tick.Label.Rotation = math.Pi / 6
+	p.X.Tick.Label.YAlign = draw.YCenter
+	p.X.Tick.Label.XAlign = draw.XRight
+	p.Y.Min = 0
+	p.Y.Max = 100
+	return p, nil
+}
+
+// PlotTimeSlotBoxPlot: 시간(0~23시)별 슬롯 점수 분포 박스 플롯을 opts 포맷으로 렌더링
+// - data: 여러 일자의 FocusData 배열
+// - opts: 출력 포맷/크기/DPI, 테마
+// 반환: 이미지 []byte, 에러
+func PlotTimeSlotBoxPlot(data []common.FocusData, opts RenderOptions) ([]byte, error) {
+	p, err := timeSlotBoxPlot(data, opts.theme(), opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// timeSlotBoxPlot: 시간별 슬롯 점수 박스 플롯 plot 구성
+func timeSlotBoxPlot(data []common.FocusData, theme Theme, tr i18n.Printer) (*plot.Plot, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("분석할 데이터가 없습니다")
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+
+	p := theme.newPlot()
+	p.Title.Text = tr.T("box.slot.title", tr.DateRange(data[0].Date, data[len(data)-1].Date))
+	p.Title.Padding = vg.Points(10)
+	p.X.Label.Text = tr.T("axis.hour")
+	p.X.Label.Padding = vg.Points(10)
+	p.Y.Label.Text = tr.T("axis.focusScore")
+	p.Y.Label.Padding = vg.Points(10)
+
+	hasData := false
+	for h, vals := range hourlySlotScores(data) {
+		if len(vals) == 0 {
+			continue
+		}
+		b, err := plotter.NewBoxPlot(vg.Points(14), float64(h), vals)
+		if err != nil {
+			return nil, err
+		}
+		styleBox(b, theme, theme.SeriesColor(2))
+		p.Add(b)
+		hasData = true
+	}
+	if !hasData {
+		return nil, fmt.Errorf("점수가 기록된 슬롯이 없습니다")
+	}
+	ticks := []plot.Tick{}
+	for h := 0; h < 24; h++ {
+		label := ""
+		if h%3 == 0 {
+			label = fmt.Sprintf("%d", h)
+		}
+		ticks = append(ticks, plot.Tick{Value: float64(h), Label: label})
+	}
+	p.X.Tick.Marker = plot.ConstantTicks(ticks)
+	p.X.Min, p.X.Max = -0.5, 23.5
+	p.Y.Min = 0
+	return p, nil
+}
@@ -2,47 +2,53 @@ package analyzer
 
 import (
 	"fmt"
-	"io/ioutil"
+	"os"
+	"sync"
 
+	"github.com/crispy/focus-time-tracker/fonts"
 	"golang.org/x/image/font/opentype"
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/font"
 )
 
-// InitKoreanFont loads and registers a Korean font with the Gonum plotting library
-func InitKoreanFont() error {
-	fontFilePath := FindKoreanFont()
-	if fontFilePath == "" {
-		return fmt.Errorf("no Korean font found")
-	}
+var (
+	koreanFontOnce sync.Once
+	koreanFontErr  error
+	koreanFontPath string
+)
 
-	// Read the font file
-	fontData, err := ioutil.ReadFile(fontFilePath)
-	if err != nil {
-		return fmt.Errorf("error reading font file: %v", err)
-	}
+// SetKoreanFontPath: 임베드된 기본 폰트(D2Coding) 대신 사용할 한글 폰트 파일 지정
+// - path: TTF/OTF 경로 (빈 문자열이면 기본 폰트)
+// 첫 그래프 렌더링(InitKoreanFont) 전에 호출해야 적용됨
+func SetKoreanFontPath(path string) {
+	koreanFontPath = path
+}
 
-	// Parse the font
-	fontFace, err := opentype.Parse(fontData)
+// InitKoreanFont: 한글 폰트를 gonum/plot 기본 폰트로 한 번만 등록
+// 반환: 등록 에러 (이후 호출은 첫 결과를 그대로 반환)
+func InitKoreanFont() error {
+	koreanFontOnce.Do(func() {
+		koreanFontErr = registerKoreanFont(koreanFontPath)
+	})
+	return koreanFontErr
+}
+
+// registerKoreanFont: 폰트 파일(없으면 임베드 폰트)을 파싱해 기본 폰트로 등록
+func registerKoreanFont(path string) error {
+	data := fonts.D2Coding
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("한글 폰트 파일 읽기 실패 (%s): %w", path, err)
+		}
+		data = b
+	}
+	face, err := opentype.Parse(data)
 	if err != nil {
-		return fmt.Errorf("error parsing font: %v", err)
+		return fmt.Errorf("한글 폰트 파싱 실패: %w", err)
 	}
-
-	// Create a font.Collection with the Korean font
 	koreanFont := font.Font{Typeface: "KoreanFont"}
-	collection := font.Collection{
-		{
-			Font: koreanFont,
-			Face: fontFace,
-		},
-	}
-
-	// Add the font to the default font cache
-	font.DefaultCache.Add(collection)
-
-	// Set it as the default font for plots
+	font.DefaultCache.Add(font.Collection{{Font: koreanFont, Face: face}})
 	plot.DefaultFont = koreanFont
-
-	fmt.Printf("Successfully registered Korean font from: %s\n", fontFilePath)
 	return nil
-} 
\ No newline at end of file
+}
@@ -0,0 +1,167 @@
+package analyzer
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// WeekdayHeatmapOptions: 시간대×요일 히트맵 옵션
+// - Category: 지정하면 해당 카테고리로 기록된 슬롯만 집계 (slotLabels 없는 옛 데이터는 제외)
+// - Hourly: true면 24×7(1시간 평균), false면 기본 슬롯 단위(10분, 144×7)
+type WeekdayHeatmapOptions struct {
+	Category string
+	Hourly   bool
+}
+
+// weekdayRows: 히트맵 행 순서 (위에서부터 월~일)
+var weekdayRows = []time.Weekday{
+	time.Sunday, time.Saturday, time.Friday, time.Thursday, time.Wednesday, time.Tuesday, time.Monday,
+}
+
+// weekdayGrid: plotter.GridXYZ 구현 (열=시간대, 행=요일, 값=평균 점수, 기록 없으면 NaN)
+type weekdayGrid struct {
+	cols     int
+	colWidth float64 // 열 하나의 시간(시) 폭
+	z        [][]float64
+}
+
+func (g *weekdayGrid) Dims() (c, r int)   { return g.cols, len(weekdayRows) }
+func (g *weekdayGrid) Z(c, r int) float64 { return g.z[r][c] }
+func (g *weekdayGrid) X(c int) float64    { return (float64(c) + 0.5) * g.colWidth }
+func (g *weekdayGrid) Y(r int) float64    { return float64(r) }
+
+// weekdaySlotAverages: 요일×시간대별 평균 몰입 점수 (0점 제외, 다른 시간대 그래프와 동일 기준)
+// - 슬롯 길이가 기본값과 다른 기록은 기본 슬롯 길이로 변환해 집계
+func weekdaySlotAverages(data []common.FocusData, opts WeekdayHeatmapOptions) *weekdayGrid {
+	data = ResampleHistory(data, common.DefaultSlotMinutes)
+	cols, colMinutes := common.DefaultSlotLayout.PerDay(), common.DefaultSlotMinutes
+	if opts.Hourly {
+		cols, colMinutes = 24, 60
+	}
+	sum := make([][]float64, len(weekdayRows))
+	count := make([][]float64, len(weekdayRows))
+	for r := range weekdayRows {
+		sum[r] = make([]float64, cols)
+		count[r] = make([]float64, cols)
+	}
+	rowOf := map[time.Weekday]int{}
+	for r, w := range weekdayRows {
+		rowOf[w] = r
+	}
+	for _, d := range data {
+		date, err := time.Parse("2006-01-02", d.Date)
+		if err != nil {
+			continue
+		}
+		if opts.Category != "" && d.SlotLabels == nil {
+			continue
+		}
+		r := rowOf[date.Weekday()]
+		for key, v := range d.TimeSlots {
+			if v == 0 {
+				continue
+			}
+			if opts.Category != "" && d.SlotLabels[key] != opts.Category {
+				continue
+			}
+			var h, m int
+			if _, err := fmt.Sscanf(key, "%02d:%02d", &h, &m); err != nil {
+				continue
+			}
+			c := (h*60 + m) / colMinutes
+			if c < 0 || c >= cols {
+				continue
+			}
+			sum[r][c] += float64(v)
+			count[r][c]++
+		}
+	}
+	g := &weekdayGrid{cols: cols, colWidth: float64(colMinutes) / 60.0, z: make([][]float64, len(weekdayRows))}
+	for r := range weekdayRows {
+		g.z[r] = make([]float64, cols)
+		for c := 0; c < cols; c++ {
+			if count[r][c] == 0 {
+				g.z[r][c] = math.NaN()
+				continue
+			}
+			g.z[r][c] = sum[r][c] / count[r][c]
+		}
+	}
+	return g
+}
+
+// PlotWeekdayHeatmap: 시간대×요일 평균 몰입 점수 히트맵을 opts 포맷으로 렌더링
+// - data: 여러 일자의 FocusData 배열
+// - hm: 카테고리 필터, 해상도(24열/기본 슬롯 단위)
+// - opts: 출력 포맷/크기/DPI
+// 반환: 이미지 []byte, 에러
+func PlotWeekdayHeatmap(data []common.FocusData, hm WeekdayHeatmapOptions, opts RenderOptions) ([]byte, error) {
+	p, err := weekdayHeatmapPlot(data, hm, opts.theme(), opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// weekdayHeatmapPlot: 시간대×요일 히트맵 plot 구성
+func weekdayHeatmapPlot(data []common.FocusData, hm WeekdayHeatmapOptions, theme Theme, tr i18n.Printer) (*plot.Plot, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("분석할 데이터가 없습니다")
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+	grid := weekdaySlotAverages(data, hm)
+
+	// 값 범위 (NaN 제외), 기록이 하나도 없으면 0~100
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, row := range grid.z {
+		for _, v := range row {
+			if math.IsNaN(v) {
+				continue
+			}
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+	}
+	if math.IsInf(min, 1) {
+		min, max = 0, 100
+	}
+	if max <= min {
+		max = min + 1
+	}
+
+	p := theme.newPlot()
+	p.Title.Text = tr.T("heatmap.title")
+	if hm.Category != "" {
+		p.Title.Text = tr.T("heatmap.cat", tr.Category(hm.Category))
+	}
+	p.Title.Padding = vg.Points(10)
+	heat := plotter.NewHeatMap(grid, palette.Heat(16, 1))
+	heat.Min, heat.Max = min, max
+	heat.NaN = theme.Missing
+	p.Add(heat)
+
+	xticks := []plot.Tick{}
+	for h := 0; h <= 24; h += 3 {
+		xticks = append(xticks, plot.Tick{Value: float64(h), Label: fmt.Sprintf("%d", h)})
+	}
+	p.X.Tick.Marker = plot.ConstantTicks(xticks)
+	yticks := make([]plot.Tick, 0, len(weekdayRows))
+	for r, w := range weekdayRows {
+		yticks = append(yticks, plot.Tick{Value: float64(r), Label: tr.Weekday(w)})
+	}
+	p.Y.Tick.Marker = plot.ConstantTicks(yticks)
+	p.X.Label.Text = tr.T("heatmap.legend", min, max)
+	p.X.Label.Padding = vg.Points(10)
+	p.X.Min, p.X.Max = 0, 24
+	return p, nil
+}
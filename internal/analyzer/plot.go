@@ -1,71 +1,19 @@
 package analyzer
 
 import (
-	"bytes"
 	"fmt"
-	"image/color"
 	"math"
-	"os"
-	"path/filepath"
 	"sort"
 	"time"
 
 	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/plotter"
-	"gonum.org/v1/plot/plotutil"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 )
 
-// FindKoreanFont attempts to find a Korean font on the system
-func FindKoreanFont() string {
-	// Check if KOREAN_FONT_PATH is set in environment
-	if fontPath := os.Getenv("KOREAN_FONT_PATH"); fontPath != "" {
-		if _, err := os.Stat(fontPath); err == nil {
-			return fontPath
-		}
-	}
-
-	// Check the fonts directory first (relative to current directory)
-	fontPaths := []string{
-		"fonts/D2Coding/D2Coding-Ver1.3.2-20180524.ttf",
-		"fonts/D2CodingLigature/D2Coding-Ver1.3.2-20180524-ligature.ttf",
-	}
-	
-	// Get absolute path to project root
-	cwd, err := os.Getwd()
-	if err == nil {
-		for _, path := range fontPaths {
-			absPath := filepath.Join(cwd, path)
-			if _, err := os.Stat(absPath); err == nil {
-				fmt.Printf("Found Korean font: %s\n", absPath)
-				return absPath
-			}
-		}
-	}
-	
-	// If we didn't find any custom fonts, try system fonts
-	systemFontPaths := []string{
-		// Linux
-	}
-	
-	// Try user's home directory
-	home, err := os.UserHomeDir()
-	if err == nil {
-		// Linux user fonts
-		systemFontPaths = append(systemFontPaths, filepath.Join(home, ".fonts/NanumGothic.ttf"))
-	}
-	
-	for _, path := range systemFontPaths {
-		if _, err := os.Stat(path); err == nil {
-			return path
-		}
-	}
-	
-	return ""
-}
-
 // makeCategoryPoints: 카테고리별 데이터 포인트 생성
 // - data: 여러 일자의 FocusData 배열
 // - category: 카테고리명
@@ -110,50 +58,85 @@ func makeRegressionPoints(data []common.FocusData, category string) plotter.XYs
 
 // makeEvalText: 평가 텍스트 생성 (카테고리별 slope 해석)
 // - data: 여러 일자의 FocusData 배열
+// - tr: 카테고리 이름/트렌드 단어 언어
 // 반환: 카테고리별 트렌드(상승/감소/유지) 텍스트
-func makeEvalText(data []common.FocusData) string {
+func makeEvalText(data []common.FocusData, tr i18n.Printer) string {
 	eval := ""
 	for _, cat := range common.Categories {
 		slope, _ := Regression(data, cat)
 		trend := ""
 		if slope > 1 {
-			trend = "상승"
+			trend = tr.T("trend.up")
 		} else if slope < -1 {
-			trend = "감소"
+			trend = tr.T("trend.down")
 		} else {
-			trend = "유지"
+			trend = tr.T("trend.flat")
 		}
-		eval += fmt.Sprintf("%s: %.2f (%s)  ", cat, slope, trend)
+		eval += tr.T("trend.eval", tr.Category(cat), slope, trend)
 	}
 	return eval
 }
 
-// makeWatermark: 워터마크(오늘 날짜/시간) 텍스트 생성
-func makeWatermark() string {
+// EvalText: 카테고리별 회귀 기울기 평가 텍스트 (그래프 밖 리포트 등에서 사용)
+// - data: 여러 일자의 FocusData 배열
+// - opts: 카테고리 이름/트렌드 단어 언어 (Locale만 사용)
+// 반환: "카테고리: 기울기 (상승/감소/유지)" 나열 텍스트
+func EvalText(data []common.FocusData, opts RenderOptions) string {
+	return makeEvalText(data, opts.printer())
+}
+
+// dateLabel: "2006-01-02" 날짜 → 눈금/범례용 짧은 날짜 (파싱 실패 시 원본)
+func dateLabel(tr i18n.Printer, date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return tr.ShortDate(t)
+}
+
+// makeWatermark: 워터마크(기준 시각의 한국 날짜/시간) 텍스트 생성
+// - now: 기준 시각
+func makeWatermark(now time.Time) string {
 	loc, err := time.LoadLocation("Asia/Seoul")
 	if err == nil {
-		return time.Now().In(loc).Format("2006-01-02 15:04:05")
+		return now.In(loc).Format("2006-01-02 15:04:05")
 	}
-	return time.Now().Format("2006-01-02 15:04:05")
+	return now.Format("2006-01-02 15:04:05")
 }
 
 // PlotTimeSlotAverageFocusPNG: 시간대별 일자별 평균 몰입 점수 그래프를 PNG로 저장
 // - data: 여러 일자의 FocusData 배열
 // 반환: PNG 이미지 []byte, 에러
 func PlotTimeSlotAverageFocusPNG(data []common.FocusData) ([]byte, error) {
+	return PlotTimeSlotAverageFocus(data, DefaultRenderOptions())
+}
+
+// PlotTimeSlotAverageFocus: 시간대별 일자별 평균 몰입 점수 그래프를 opts 포맷으로 렌더링
+// - data: 여러 일자의 FocusData 배열
+// - opts: 출력 포맷/크기/DPI, 테마, 기준 시각/워터마크
+// 반환: 이미지 []byte, 에러
+func PlotTimeSlotAverageFocus(data []common.FocusData, opts RenderOptions) ([]byte, error) {
+	p, err := timeSlotAverageFocusPlot(data, opts.theme(), opts.printer(), opts.watermark())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// timeSlotAverageFocusPlot: 시간대별 일자별 평균 몰입 점수 plot 구성
+func timeSlotAverageFocusPlot(data []common.FocusData, theme Theme, tr i18n.Printer, watermark string) (*plot.Plot, error) {
 	// Initialize Korean font
 	if err := InitKoreanFont(); err != nil {
-		fmt.Printf("Warning: failed to initialize Korean font: %v\n", err)
-		fmt.Println("Korean characters may not display correctly.")
+		return nil, err
 	}
 
-	p := plot.New()
-	p.Title.Text = "시간대별 일자별 평균 몰입 점수"
+	p := theme.newPlot()
+	p.Title.Text = tr.T("timeslot.title")
 	// 제목과 라벨 사이에 여백 늘리기
 	p.Title.Padding = vg.Points(10)
 	
-	p.X.Label.Text = "시간"
-	p.Y.Label.Text = "평균 몰입 점수"
+	p.X.Label.Text = tr.T("axis.hour")
+	p.Y.Label.Text = tr.T("axis.avgScore")
 	
 	// 라벨과 축 사이 여백 늘리기
 	p.X.Label.Padding = vg.Points(10)
@@ -163,37 +146,27 @@ func PlotTimeSlotAverageFocusPNG(data []common.FocusData) ([]byte, error) {
 	p.X.Padding = vg.Points(5)
 	p.Y.Padding = vg.Points(5)
 	
-	// 폰트 경로 출력 (디버그용)
-	fontPath := FindKoreanFont()
-	if fontPath != "" {
-		fmt.Printf("Using Korean font: %s\n", fontPath)
-	} else {
-		fmt.Println("Warning: Korean font not found. Korean characters may not display correctly.")
-	}
-
 	// X축 눈금 간격 설정
 	p.X.Tick.Marker = plot.ConstantTicks([]plot.Tick{
 		{Value: 0, Label: "0"}, {Value: 6, Label: "6"}, {Value: 12, Label: "12"}, {Value: 18, Label: "18"}, {Value: 24, Label: "24"},
 	})
 	p.X.LineStyle.Width = vg.Points(1)
-	p.X.LineStyle.Color = color.Gray{Y: 128}
-	
-	// 눈금 레이블 크기 조정
-	p.X.Tick.Label.Font.Size = vg.Points(10)
-	p.Y.Tick.Label.Font.Size = vg.Points(10)
+	p.X.LineStyle.Color = theme.Grid
 
 	// 워터마크 추가 (오른쪽 하단에 보이도록 위치 조정)
-	labels, err := plotter.NewLabels(plotter.XYLabels{
-		XYs:    []plotter.XY{{X: 23, Y: 5}},
-		Labels: []string{makeWatermark()},
-	})
-	if err == nil && labels != nil && len(labels.Labels) > 0 && len(labels.XYs) > 0 {
-		// 워터마크 글꼴 크기 조정
-		labels.TextStyle[0].Font.Size = vg.Points(8)
-		p.Add(labels)
+	if watermark != "" {
+		labels, err := plotter.NewLabels(plotter.XYLabels{
+			XYs:    []plotter.XY{{X: 23, Y: 5}},
+			Labels: []string{watermark},
+		})
+		if err == nil && labels != nil && len(labels.Labels) > 0 && len(labels.XYs) > 0 {
+			// 워터마크 글꼴 크기 조정
+			labels.TextStyle[0].Font.Size = vg.Points(8)
+			labels.TextStyle[0].Color = theme.Foreground
+			p.Add(labels)
+		}
 	}
 
-	colors := plotutil.SoftColors
 	for idx, d := range data {
 		// 시간대별 점수 집계 (0점 제외)
 		timeSlotSum := map[string]int{}
@@ -242,10 +215,14 @@ func PlotTimeSlotAverageFocusPNG(data []common.FocusData) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		l.Color = colors[idx%len(colors)]
-		l.Width = vg.Points(2)
+		l.Color = theme.SeriesColor(idx)
+		l.Width = theme.LineWidth
 		p.Add(l)
-		p.Legend.Add(d.Date, l)
+		label := dateLabel(tr, d.Date)
+		if d.Date == aggregateDate {
+			label = tr.T("timeslot.aggregate")
+		}
+		p.Legend.Add(label, l)
 	}
 	
 	// 범례 설정
@@ -254,24 +231,12 @@ func PlotTimeSlotAverageFocusPNG(data []common.FocusData) ([]byte, error) {
 	p.Legend.XOffs = vg.Points(-10) 
 	p.Legend.YOffs = vg.Points(10)
 	p.Legend.Padding = vg.Points(8)
-	p.Legend.TextStyle.Font.Size = vg.Points(10)
 	p.Legend.ThumbnailWidth = vg.Points(30)
 	
 	p.X.Min = 0
 	p.X.Max = 24
 	p.Y.Min = 0
-	
-	buf := &bytes.Buffer{}
-	// 이미지 크기를 키워서 여백을 더 확보
-	w, err := p.WriterTo(vg.Points(1280), vg.Points(640), "png")
-	if err != nil {
-		return nil, err
-	}
-	_, err = w.WriteTo(buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return p, nil
 }
 
 // DrawFocusTrends: 준비된 데이터(points, regressionLines, evalText, watermark, aggregateLine, categories)로 그림만 그림
@@ -282,21 +247,30 @@ func PlotTimeSlotAverageFocusPNG(data []common.FocusData) ([]byte, error) {
 // - aggregateLine: 전체 평균 라인 (없으면 nil)
 // - data: 추가 데이터 배열
 // - categories: 동적으로 추출된 카테고리 목록
-// 반환: PNG 이미지 []byte, 에러
-func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, watermark string, aggregateLine plotter.XYs, data []common.FocusData, categories []string) ([]byte, error) {
+// - opts: 출력 포맷/크기/DPI
+// 반환: 이미지 []byte, 에러
+func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, watermark string, aggregateLine plotter.XYs, data []common.FocusData, categories []string, opts RenderOptions) ([]byte, error) {
+	p, err := focusTrendsPlot(points, regressionLines, evalText, watermark, aggregateLine, data, categories, opts.theme(), opts.printer(), opts.now())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// focusTrendsPlot: DrawFocusTrends의 plot 구성 (렌더링 전 단계)
+func focusTrendsPlot(points, regressionLines map[string]plotter.XYs, evalText, watermark string, aggregateLine plotter.XYs, data []common.FocusData, categories []string, theme Theme, tr i18n.Printer, now time.Time) (*plot.Plot, error) {
 	// Initialize Korean font
 	if err := InitKoreanFont(); err != nil {
-		fmt.Printf("Warning: failed to initialize Korean font: %v\n", err)
-		fmt.Println("Korean characters may not display correctly.")
+		return nil, err
 	}
 	
-	p := plot.New()
-	p.Title.Text = "카테고리별 트렌드 및 회귀선"
+	p := theme.newPlot()
+	p.Title.Text = tr.T("trends.title")
 	// 제목과 라벨 사이에 여백 늘리기
 	p.Title.Padding = vg.Points(10)
 	
-	p.X.Label.Text = "일자"
-	p.Y.Label.Text = "점수"
+	p.X.Label.Text = tr.T("axis.date")
+	p.Y.Label.Text = tr.T("axis.score")
 	
 	// 라벨과 축 사이 여백 늘리기
 	p.X.Label.Padding = vg.Points(10)
@@ -306,16 +280,9 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 	p.X.Padding = vg.Points(5)
 	p.Y.Padding = vg.Points(5)
 	
-	// 폰트 경로 출력 (디버그용)
-	fontPath := FindKoreanFont()
-	if fontPath != "" {
-		fmt.Printf("Using Korean font: %s\n", fontPath)
-	} else {
-		fmt.Println("Warning: Korean font not found. Korean characters may not display correctly.")
-	}
-
-	// 오늘 기준 ±6일 x축 생성
-	today := time.Now().Truncate(24 * time.Hour)
+	// 오늘(기준 시각의 날짜) 기준 ±6일 x축 생성
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
 	dates := make([]string, 0, 13)
 	dateToX := map[string]float64{}
 	for i := -6; i <= 6; i++ {
@@ -330,9 +297,9 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 	for i, d := range dates {
 		// 표시 간격 넓히기 (짝수 인덱스만 레이블 표시)
 		if i%2 == 0 {
-			label := d
+			label := dateLabel(tr, d)
 			if i == 6 {
-				label += " (오늘)"
+				label = tr.T("trends.today", label)
 			}
 			ticks = append(ticks, plot.Tick{Value: float64(i), Label: label})
 		} else {
@@ -347,15 +314,9 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 	p.X.Tick.Label.YAlign = draw.YCenter
 	p.X.Tick.Label.XAlign = draw.XRight
 	
-	// 레이블 글꼴 크기 조정
-	p.X.Tick.Label.Font.Size = vg.Points(10)
-	p.Y.Tick.Label.Font.Size = vg.Points(10)
-	
 	p.X.Min = 0
 	p.X.Max = 12
 
-	colors := plotutil.SoftColors
-	colorIdx := 0
 	for _, cat := range categories {
 		pts := points[cat]
 		// pts의 X를 실제 일자 기반으로 재설정 (data[i].Date 사용)
@@ -378,10 +339,10 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 			if err != nil {
 				return nil, err
 			}
-			l.Color = colors[colorIdx%len(colors)]
-			l.Width = vg.Points(2)
+			l.Color = theme.CategoryLineColor(cat)
+			l.Width = theme.LineWidth
 			p.Add(l)
-			p.Legend.Add(cat+"(실제)", l)
+			p.Legend.Add(tr.T("trends.actual", tr.Category(cat)), l)
 		}
 
 		if regPts, ok := regressionLines[cat]; ok {
@@ -417,14 +378,13 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 				if err != nil {
 					return nil, err
 				}
-				rl.Color = colors[colorIdx%len(colors)]
+				rl.Color = theme.CategoryLineColor(cat)
 				rl.Dashes = []vg.Length{vg.Points(4), vg.Points(4)}
-				rl.Width = vg.Points(2)
+				rl.Width = theme.LineWidth
 				p.Add(rl)
-				p.Legend.Add(cat+"(회귀)", rl)
+				p.Legend.Add(tr.T("trends.reg", tr.Category(cat)), rl)
 			}
 		}
-		colorIdx++
 	}
 
 	// aggregateLine이 있으면 굵은 검정색 선으로 항상 추가
@@ -459,10 +419,10 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 			if err != nil {
 				return nil, err
 			}
-			aggLine.Color = color.Black
-			aggLine.Width = vg.Points(4)
+			aggLine.Color = theme.Foreground
+			aggLine.Width = theme.LineWidth * 2
 			p.Add(aggLine)
-			p.Legend.Add("전체 평균", aggLine)
+			p.Legend.Add(tr.T("trends.average"), aggLine)
 		}
 	}
 
@@ -471,7 +431,6 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 	p.Legend.XOffs = vg.Points(-10)
 	p.Legend.YOffs = vg.Points(10)
 	p.Legend.Padding = vg.Points(8)   // 범례 내부 여백
-	p.Legend.TextStyle.Font.Size = vg.Points(10)
 	p.Legend.ThumbnailWidth = vg.Points(30)  // 범례 썸네일 크기
 
 	// 평가 텍스트 추가
@@ -482,6 +441,7 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 		})
 		if err == nil {
 			labels.TextStyle[0].Font.Size = vg.Points(10)
+			labels.TextStyle[0].Color = theme.Foreground
 			p.Add(labels)
 		}
 	}
@@ -493,44 +453,12 @@ func DrawFocusTrends(points, regressionLines map[string]plotter.XYs, evalText, w
 		})
 		if err == nil {
 			labels.TextStyle[0].Font.Size = vg.Points(8)
-			p.Add(labels)
-		}
-	}
-
-	// 색상 설명 추가 - 별도 라벨로 나누기
-	legendDescs := []string{
-		"빨간색(업무)",
-		"파란색(학습)",
-		"초록색(취미)",
-		"보라색(수면)",
-		"주황색(이동)",
-		"검정(전체 평균)",
-	}
-	
-	for i, desc := range legendDescs {
-		posX := float64(i*2)  // 간격을 두고 배치
-		labels, _ := plotter.NewLabels(plotter.XYLabels{
-			XYs:    []plotter.XY{{X: posX, Y: 5}},
-			Labels: []string{desc},
-		})
-		if labels != nil {
-			labels.TextStyle[0].Font.Size = vg.Points(9)
+			labels.TextStyle[0].Color = theme.Foreground
 			p.Add(labels)
 		}
 	}
 
 	p.Y.Min = 0
 	p.Y.Max = 100
-
-	buf := &bytes.Buffer{}
-	// 이미지 크기를 키워서 여백을 더 확보
-	w, err := p.WriterTo(vg.Points(1280), vg.Points(640), "png")
-	if err != nil {
-		return nil, err
-	}
-	_, err = w.WriteTo(buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
+	return p, nil
 }
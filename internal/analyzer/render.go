@@ -0,0 +1,163 @@
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgeps"
+	"gonum.org/v1/plot/vg/vgimg"
+	"gonum.org/v1/plot/vg/vgpdf"
+	"gonum.org/v1/plot/vg/vgsvg"
+)
+
+// Format: 그래프 출력 포맷 (png, svg, pdf, eps)
+type Format string
+
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+	FormatPDF Format = "pdf"
+	FormatEPS Format = "eps"
+)
+
+// ParseFormat: 문자열 → Format 변환 (대소문자/앞의 점 무시, 빈 문자열은 PNG)
+// 반환: Format, 에러 (지원하지 않는 포맷)
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimPrefix(strings.TrimSpace(s), ".")))
+	switch f {
+	case "":
+		return FormatPNG, nil
+	case FormatPNG, FormatSVG, FormatPDF, FormatEPS:
+		return f, nil
+	}
+	return "", fmt.Errorf("지원하지 않는 그래프 포맷: %q", s)
+}
+
+// Ext: 포맷에 맞는 파일 확장자 (예: ".svg")
+func (f Format) Ext() string {
+	if f == "" {
+		return "." + string(FormatPNG)
+	}
+	return "." + string(f)
+}
+
+// RenderOptions: 그래프 렌더링 옵션 (포맷, 크기, DPI, 테마)
+// - Format: 출력 포맷 (기본 PNG)
+// - Width, Height: 그림 크기 (기본 1280x640pt)
+// - DPI: 래스터(PNG) 해상도 (기본 96, 벡터 포맷에서는 무시)
+// - Theme: 색상/글꼴 테마 (nil이면 LightTheme)
+// - Now: 기준 시각 ("오늘" 축, 워터마크). 비어 있으면 time.Now()
+// - NoWatermark: true면 워터마크를 그리지 않음
+// - Locale: 제목/축/범례 언어 (비어 있으면 i18n.DefaultLocale)
+type RenderOptions struct {
+	Format      Format
+	Width       vg.Length
+	Height      vg.Length
+	DPI         int
+	Theme       *Theme
+	Now         time.Time
+	NoWatermark bool
+	Locale      i18n.Locale
+}
+
+// theme: 옵션의 테마 (없으면 LightTheme)
+func (o RenderOptions) theme() Theme {
+	if o.Theme == nil {
+		return LightTheme()
+	}
+	return *o.Theme
+}
+
+// printer: 옵션 언어의 문구 Printer
+func (o RenderOptions) printer() i18n.Printer {
+	return i18n.New(o.Locale)
+}
+
+// now: 옵션의 기준 시각 (없으면 현재 시각)
+func (o RenderOptions) now() time.Time {
+	if o.Now.IsZero() {
+		return time.Now()
+	}
+	return o.Now
+}
+
+// watermark: 옵션에 맞는 워터마크 텍스트 (NoWatermark면 빈 문자열)
+func (o RenderOptions) watermark() string {
+	if o.NoWatermark {
+		return ""
+	}
+	return makeWatermark(o.now())
+}
+
+// DefaultRenderOptions: 기존 동작과 같은 PNG 1280x640 옵션
+func DefaultRenderOptions() RenderOptions {
+	return RenderOptions{
+		Format: FormatPNG,
+		Width:  vg.Points(1280),
+		Height: vg.Points(640),
+		DPI:    vgimg.DefaultDPI,
+	}
+}
+
+// withDefaults: 비어 있는 항목을 기본값으로 채운 옵션 반환
+func (o RenderOptions) withDefaults() RenderOptions {
+	def := DefaultRenderOptions()
+	if o.Format == "" {
+		o.Format = def.Format
+	}
+	if o.Width <= 0 {
+		o.Width = def.Width
+	}
+	if o.Height <= 0 {
+		o.Height = def.Height
+	}
+	if o.DPI <= 0 {
+		o.DPI = def.DPI
+	}
+	return o
+}
+
+// newCanvas: 옵션에 맞는 캔버스 생성
+func newCanvas(o RenderOptions) (vg.CanvasWriterTo, error) {
+	switch o.Format {
+	case FormatPNG:
+		return vgimg.PngCanvas{Canvas: vgimg.NewWith(vgimg.UseWH(o.Width, o.Height), vgimg.UseDPI(o.DPI))}, nil
+	case FormatSVG:
+		return vgsvg.NewWith(vgsvg.UseWH(o.Width, o.Height)), nil
+	case FormatPDF:
+		c := vgpdf.New(o.Width, o.Height)
+		c.EmbedFonts(true)
+		return c, nil
+	case FormatEPS:
+		return vgeps.New(o.Width, o.Height), nil
+	}
+	return nil, fmt.Errorf("지원하지 않는 그래프 포맷: %q", o.Format)
+}
+
+// renderPlot: plot을 옵션에 맞는 포맷으로 그려 바이트로 반환
+func renderPlot(p *plot.Plot, opts RenderOptions) ([]byte, error) {
+	return renderCanvas(opts, func(dc draw.Canvas) {
+		p.Draw(dc)
+	})
+}
+
+// renderCanvas: 캔버스를 만들고 drawFn으로 그린 뒤 바이트로 반환
+func renderCanvas(opts RenderOptions, drawFn func(dc draw.Canvas)) ([]byte, error) {
+	opts = opts.withDefaults()
+	c, err := newCanvas(opts)
+	if err != nil {
+		return nil, err
+	}
+	drawFn(draw.New(c))
+	buf := &bytes.Buffer{}
+	if _, err := c.WriteTo(buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
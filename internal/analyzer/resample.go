@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+)
+
+// Resample: FocusData를 다른 슬롯 길이로 변환 (슬롯 길이가 섞인 기록을 같은 기준으로 분석할 때 사용)
+// - 슬롯 점수는 그 슬롯 동안의 몰입 강도로 보고 분 단위로 펼친 뒤 새 슬롯마다 평균 (빈 분은 0)
+// - 시간대 카테고리는 새 슬롯 안에서 가장 오래 기록된 카테고리 (같으면 먼저 나온 것)
+// - 합계(TotalFocus, Categories, MaxScore)는 슬롯 수 비율만큼 조정 (반올림)
+// - minutes: 새 슬롯 길이 (분, 0이면 DefaultSlotMinutes)
+// 반환: 변환된 FocusData (원본은 변경하지 않음)
+func Resample(d common.FocusData, minutes int) common.FocusData {
+	src := common.SlotMinutesOf(d)
+	dst := common.SlotLayout{Minutes: minutes}.SlotMinutes()
+	if src == dst {
+		d.SlotMinutes = dst
+		return d
+	}
+	factor := float64(src) / float64(dst)
+	scale := func(m map[string]int) map[string]int {
+		if m == nil {
+			return nil
+		}
+		out := make(map[string]int, len(m))
+		for k, v := range m {
+			out[k] = int(math.Round(float64(v) * factor))
+		}
+		return out
+	}
+	out := common.FocusData{
+		Date:        d.Date,
+		TotalFocus:  int(math.Round(float64(d.TotalFocus) * factor)),
+		MaxScore:    scale(d.MaxScore),
+		Categories:  scale(d.Categories),
+		TimeSlots:   map[string]int{},
+		SlotMinutes: dst,
+	}
+	layout := common.SlotLayout{Minutes: dst}
+
+	sums := map[int]float64{}
+	for key, v := range d.TimeSlots {
+		start, ok := slotKeyMinutes(key)
+		if !ok {
+			continue
+		}
+		for t := start; t < start+src && t < 24*60; t++ {
+			sums[t/dst] += float64(v) / float64(dst)
+		}
+	}
+	for b, sum := range sums {
+		out.TimeSlots[layout.Key(b)] = int(math.Round(sum))
+	}
+
+	if d.SlotLabels != nil {
+		out.SlotLabels = map[string]string{}
+		// 먼저 나온 카테고리가 이기도록 시간순으로 누적
+		keys := make([]string, 0, len(d.SlotLabels))
+		for key := range d.SlotLabels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		labelMinutes := map[int]map[string]int{}
+		for _, key := range keys {
+			start, ok := slotKeyMinutes(key)
+			if !ok {
+				continue
+			}
+			label := d.SlotLabels[key]
+			for t := start; t < start+src && t < 24*60; t++ {
+				b := t / dst
+				if labelMinutes[b] == nil {
+					labelMinutes[b] = map[string]int{}
+				}
+				labelMinutes[b][label]++
+				if best, ok := out.SlotLabels[layout.Key(b)]; !ok || labelMinutes[b][label] > labelMinutes[b][best] {
+					out.SlotLabels[layout.Key(b)] = label
+				}
+			}
+		}
+	}
+	return out
+}
+
+// ResampleHistory: 여러 일자의 FocusData를 같은 슬롯 길이로 변환
+// - minutes: 새 슬롯 길이 (분, 0이면 DefaultSlotMinutes)
+// 반환: 변환된 FocusData 배열 (원본 순서 유지)
+func ResampleHistory(data []common.FocusData, minutes int) []common.FocusData {
+	out := make([]common.FocusData, len(data))
+	for i, d := range data {
+		out[i] = Resample(d, minutes)
+	}
+	return out
+}
+
+// slotKeyMinutes: 시간대 키("09:30") → 0시부터의 분
+func slotKeyMinutes(key string) (int, bool) {
+	var h, m int
+	if _, err := fmt.Sscanf(key, "%02d:%02d", &h, &m); err != nil || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, false
+	}
+	return h*60 + m, true
+}
@@ -0,0 +1,154 @@
+package analyzer
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// categorySlots: 카테고리별 기록된 슬롯 수 (AnalyzeFocus가 슬롯마다 MaxScore를 MaxSlotScore씩 더하므로 MaxScore/MaxSlotScore)
+func categorySlots(d common.FocusData, cat string) float64 {
+	return float64(d.MaxScore[cat]) / common.MaxSlotScore
+}
+
+// orderedCategories: common.Categories 순서 + 데이터에만 있는 카테고리(이름순)
+func orderedCategories(data []common.FocusData) []string {
+	known := map[string]bool{}
+	cats := make([]string, 0, len(common.Categories))
+	for _, c := range common.Categories {
+		known[c] = true
+		cats = append(cats, c)
+	}
+	extra := []string{}
+	for _, d := range data {
+		for c := range d.MaxScore {
+			if !known[c] {
+				known[c] = true
+				extra = append(extra, c)
+			}
+		}
+	}
+	sort.Strings(extra)
+	return append(cats, extra...)
+}
+
+// PlotCategoryShare: 일자별 카테고리 기록 시간 누적 영역 그래프를 opts 포맷으로 렌더링
+// - data: 여러 일자의 FocusData 배열
+// - normalized: true면 하루 기록 슬롯 대비 비율(%), false면 슬롯 수
+// - opts: 출력 포맷/크기/DPI
+// 반환: 이미지 []byte, 에러
+func PlotCategoryShare(data []common.FocusData, normalized bool, opts RenderOptions) ([]byte, error) {
+	p, err := categorySharePlot(data, normalized, opts.theme(), opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// categorySharePlot: 카테고리 누적 영역 plot 구성
+func categorySharePlot(data []common.FocusData, normalized bool, theme Theme, tr i18n.Printer) (*plot.Plot, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("분석할 데이터가 없습니다")
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+
+	p := theme.newPlot()
+	p.Title.Text = tr.T("share.slots")
+	p.Y.Label.Text = tr.T("axis.slots")
+	if normalized {
+		p.Title.Text = tr.T("share.ratio")
+		p.Y.Label.Text = tr.T("axis.ratio")
+	}
+	p.Title.Padding = vg.Points(10)
+	p.X.Label.Text = tr.T("axis.date")
+	p.X.Label.Padding = vg.Points(10)
+	p.Y.Label.Padding = vg.Points(10)
+
+	// 일자별 총 기록 슬롯 (정규화 분모)
+	totals := make([]float64, len(data))
+	cats := orderedCategories(data)
+	for i, d := range data {
+		for _, cat := range cats {
+			totals[i] += categorySlots(d, cat)
+		}
+	}
+
+	// 카테고리 순서대로 아래에서부터 쌓기
+	lower := make([]float64, len(data))
+	for _, cat := range cats {
+		upper := make([]float64, len(data))
+		hasData := false
+		for i, d := range data {
+			v := categorySlots(d, cat)
+			if normalized {
+				if totals[i] > 0 {
+					v = v / totals[i] * 100.0
+				} else {
+					v = 0
+				}
+			}
+			if v > 0 {
+				hasData = true
+			}
+			upper[i] = lower[i] + v
+		}
+		if !hasData {
+			continue
+		}
+		poly, err := plotter.NewPolygon(stackedArea(lower, upper))
+		if err != nil {
+			return nil, err
+		}
+		poly.Color = theme.CategoryColor(cat)
+		poly.LineStyle = draw.LineStyle{Color: theme.Grid, Width: vg.Points(0.5)}
+		p.Add(poly)
+		p.Legend.Add(tr.Category(cat), poly)
+		lower = upper
+	}
+
+	// x축 눈금: 일자 (많으면 간격을 두고 표시)
+	step := int(math.Ceil(float64(len(data)) / 14.0))
+	ticks := make([]plot.Tick, 0, len(data))
+	for i, d := range data {
+		label := ""
+		if i%step == 0 {
+			label = dateLabel(tr, d.Date)
+		}
+		ticks = append(ticks, plot.Tick{Value: float64(i), Label: label})
+	}
+	p.X.Tick.Marker = plot.ConstantTicks(ticks)
+	p.X.Tick.Label.Rotation = math.Pi / 6
+	p.X.Tick.Label.YAlign = draw.YCenter
+	p.X.Tick.Label.XAlign = draw.XRight
+
+	p.Legend.Top = true
+	p.Legend.Left = false
+	p.Legend.Padding = vg.Points(8)
+	p.Legend.ThumbnailWidth = vg.Points(30)
+	p.Y.Min = 0
+	if normalized {
+		p.Y.Max = 100
+	}
+	return p, nil
+}
+
+// stackedArea: 누적 영역 다각형 꼭짓점 (upper 정방향 + lower 역방향)
+func stackedArea(lower, upper []float64) plotter.XYs {
+	pts := make(plotter.XYs, 0, len(lower)*2)
+	for i, y := range upper {
+		pts = append(pts, plotter.XY{X: float64(i), Y: y})
+	}
+	for i := len(lower) - 1; i >= 0; i-- {
+		pts = append(pts, plotter.XY{X: float64(i), Y: lower[i]})
+	}
+	return pts
+}
@@ -0,0 +1,165 @@
+package analyzer
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"strings"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg"
+)
+
+// Theme: 모든 그래프가 공유하는 색상/글꼴/선 굵기 설정
+// - Missing: 기록이 없는 칸 색상, Empty: 기록 범위 밖(추적 전/후) 칸 색상
+// - CategoryColors: 카테고리→색상 (nil이면 common.CategoryColors, 시트와 같은 색)
+// - LineShade: 선 그래프에서 카테고리 색을 어둡게 할 비율 (0~1, 밝은 배경에서 연한 시트 색이 잘 보이도록)
+// - Palette: 카테고리가 아닌 계열(일자별 선 등)에 순서대로 쓰는 색상
+// - Font: 글꼴 (Typeface가 비면 plot.DefaultFont)
+type Theme struct {
+	Name           string
+	Background     color.Color
+	Foreground     color.Color
+	Grid           color.Color
+	Missing        color.Color
+	Empty          color.Color
+	CategoryColors map[string]color.Color
+	LineShade      float64
+	Palette        []color.Color
+	Font           font.Font
+	TitleSize      vg.Length
+	LabelSize      vg.Length
+	TickSize       vg.Length
+	LegendSize     vg.Length
+	LineWidth      vg.Length
+}
+
+// LightTheme: 흰 배경 기본 테마 (기존 그래프와 같은 글꼴 크기/선 굵기)
+func LightTheme() Theme {
+	return Theme{
+		Name:       "light",
+		Background: color.White,
+		Foreground: color.Black,
+		Grid:       color.Gray{Y: 200},
+		Missing:    color.Gray{Y: 225},
+		Empty:      color.Gray{Y: 248},
+		LineShade:  0.45,
+		Palette:    plotutil.SoftColors,
+		TitleSize:  vg.Points(12),
+		LabelSize:  vg.Points(12),
+		TickSize:   vg.Points(10),
+		LegendSize: vg.Points(10),
+		LineWidth:  vg.Points(2),
+	}
+}
+
+// DarkTheme: 어두운 배경 테마 (연한 시트 색을 그대로 선에 사용)
+func DarkTheme() Theme {
+	t := LightTheme()
+	t.Name = "dark"
+	t.Background = color.RGBA{R: 0x1e, G: 0x1e, B: 0x24, A: 0xff}
+	t.Foreground = color.RGBA{R: 0xe6, G: 0xe6, B: 0xe6, A: 0xff}
+	t.Grid = color.Gray{Y: 80}
+	t.Missing = color.Gray{Y: 60}
+	t.Empty = color.Gray{Y: 40}
+	t.LineShade = 0
+	return t
+}
+
+// ThemeByName: 이름(light, dark)으로 테마 조회 (빈 문자열은 light)
+func ThemeByName(name string) (Theme, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "light":
+		return LightTheme(), nil
+	case "dark":
+		return DarkTheme(), nil
+	}
+	return Theme{}, fmt.Errorf("알 수 없는 테마: %q", name)
+}
+
+// CategoryColor: 카테고리 채우기 색상 (테마 매핑 → common.CategoryColors → 팔레트 순)
+// - 팔레트 위치: common.Categories 순서, 정의에 없는 카테고리는 이름 해시 (같은 카테고리는 항상 같은 색)
+func (t Theme) CategoryColor(cat string) color.Color {
+	if c, ok := t.CategoryColors[cat]; ok {
+		return c
+	}
+	if rgb, ok := common.CategoryColors[cat]; ok {
+		return color.RGBA{R: uint8(rgb[0] * 255), G: uint8(rgb[1] * 255), B: uint8(rgb[2] * 255), A: 255}
+	}
+	return t.SeriesColor(categoryPaletteIndex(cat))
+}
+
+// categoryPaletteIndex: 색상 정의가 없는 카테고리의 팔레트 위치
+func categoryPaletteIndex(cat string) int {
+	for i, c := range common.Categories {
+		if c == cat {
+			return i
+		}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(cat))
+	return int(h.Sum32() & 0x7fffffff)
+}
+
+// CategoryLineColor: 선 그래프용 카테고리 색상 (LineShade만큼 어둡게)
+func (t Theme) CategoryLineColor(cat string) color.Color {
+	return shade(t.CategoryColor(cat), t.LineShade)
+}
+
+// SeriesColor: 카테고리가 아닌 i번째 계열 색상
+func (t Theme) SeriesColor(i int) color.Color {
+	if len(t.Palette) == 0 {
+		return t.Foreground
+	}
+	return t.Palette[i%len(t.Palette)]
+}
+
+// newPlot: 테마가 적용된 빈 plot 생성 (배경, 글꼴, 글꼴 크기, 축/범례 색상)
+func (t Theme) newPlot() *plot.Plot {
+	p := plot.New()
+	p.BackgroundColor = t.Background
+	texts := []*struct {
+		color *color.Color
+		font  *font.Font
+		size  vg.Length
+	}{
+		{&p.Title.TextStyle.Color, &p.Title.TextStyle.Font, t.TitleSize},
+		{&p.X.Label.TextStyle.Color, &p.X.Label.TextStyle.Font, t.LabelSize},
+		{&p.Y.Label.TextStyle.Color, &p.Y.Label.TextStyle.Font, t.LabelSize},
+		{&p.X.Tick.Label.Color, &p.X.Tick.Label.Font, t.TickSize},
+		{&p.Y.Tick.Label.Color, &p.Y.Tick.Label.Font, t.TickSize},
+		{&p.Legend.TextStyle.Color, &p.Legend.TextStyle.Font, t.LegendSize},
+	}
+	for _, ts := range texts {
+		*ts.color = t.Foreground
+		if t.Font.Typeface != "" {
+			*ts.font = t.Font
+		}
+		if ts.size > 0 {
+			ts.font.Size = ts.size
+		}
+	}
+	for _, a := range []*plot.Axis{&p.X, &p.Y} {
+		a.LineStyle.Color = t.Foreground
+		a.Tick.LineStyle.Color = t.Foreground
+	}
+	return p
+}
+
+// shade: 색상을 amount(0~1)만큼 검정 쪽으로 어둡게
+func shade(c color.Color, amount float64) color.Color {
+	if amount <= 0 {
+		return c
+	}
+	r, g, b, a := c.RGBA()
+	f := 1 - clamp01(amount)
+	return color.RGBA{
+		R: uint8(float64(r>>8) * f),
+		G: uint8(float64(g>>8) * f),
+		B: uint8(float64(b>>8) * f),
+		A: uint8(a >> 8),
+	}
+}
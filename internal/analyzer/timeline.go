@@ -0,0 +1,169 @@
+package analyzer
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// timelineBlocks: 하루=한 행, 슬롯=한 칸으로 그리는 plot.Plotter
+// - 칸 색상은 카테고리 색, 칸 높이는 집중도 점수(0~common.MaxSlotScore)에 비례
+type timelineBlocks struct {
+	days  []common.DaySlots
+	theme Theme
+}
+
+// Plot: plot.Plotter 구현
+func (t *timelineBlocks) Plot(dc draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&dc)
+	outline := draw.LineStyle{Color: t.theme.Grid, Width: vg.Points(0.5)}
+	for row, day := range t.days {
+		n := len(day.Labels)
+		if n == 0 {
+			continue
+		}
+		slotHours := 24.0 / float64(n)
+		center := float64(len(t.days) - 1 - row) // 첫째 날이 맨 위
+		// 하루 전체 테두리
+		dc.StrokeLines(outline, []vg.Point{
+			{X: trX(0), Y: trY(center - 0.4)}, {X: trX(24), Y: trY(center - 0.4)},
+			{X: trX(24), Y: trY(center + 0.4)}, {X: trX(0), Y: trY(center + 0.4)},
+			{X: trX(0), Y: trY(center - 0.4)},
+		})
+		for i, label := range day.Labels {
+			if label == "" {
+				continue
+			}
+			score := 0
+			if i < len(day.Scores) {
+				score = day.Scores[i]
+			}
+			h := blockHeight(score)
+			x0, x1 := trX(float64(i)*slotHours), trX(float64(i+1)*slotHours)
+			y0, y1 := trY(center-0.4), trY(center-0.4+h)
+			dc.FillPolygon(t.theme.CategoryColor(label), []vg.Point{{X: x0, Y: y0}, {X: x1, Y: y0}, {X: x1, Y: y1}, {X: x0, Y: y1}})
+		}
+	}
+}
+
+// blockHeight: 슬롯 점수 → 칸 높이 (행 높이 0.8 기준, 점수 0이어도 라벨이 보이도록 최소 20%)
+func blockHeight(score int) float64 {
+	return 0.8 * (0.2 + 0.8*clamp01(float64(score)/common.MaxSlotScore))
+}
+
+// DataRange: plot.DataRanger 구현
+func (t *timelineBlocks) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return 0, 24, -0.5, float64(len(t.days)) - 0.5
+}
+
+// colorThumb: 범례용 단색 썸네일
+type colorThumb struct{ c color.Color }
+
+// Thumbnail: plot.Thumbnailer 구현
+func (t colorThumb) Thumbnail(c *draw.Canvas) {
+	c.FillPolygon(t.c, []vg.Point{
+		{X: c.Min.X, Y: c.Min.Y},
+		{X: c.Max.X, Y: c.Min.Y},
+		{X: c.Max.X, Y: c.Max.Y},
+		{X: c.Min.X, Y: c.Max.Y},
+	})
+}
+
+// clamp01: 0~1 범위로 자르기
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+// PlotDailyTimeline: 시트 열과 같은 모양의 24시간 타임라인(하루 또는 여러 날을 위→아래로) 렌더링
+// - days: 하루치 슬롯 원본 배열 (한 개면 하루, 7개면 한 주)
+// - opts: 출력 포맷/크기/DPI
+// 반환: 이미지 []byte, 에러
+func PlotDailyTimeline(days []common.DaySlots, opts RenderOptions) ([]byte, error) {
+	p, err := dailyTimelinePlot(days, opts.theme(), opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	return renderPlot(p, opts)
+}
+
+// dailyTimelinePlot: 타임라인 plot 구성
+func dailyTimelinePlot(days []common.DaySlots, theme Theme, tr i18n.Printer) (*plot.Plot, error) {
+	if len(days) == 0 {
+		return nil, fmt.Errorf("분석할 데이터가 없습니다")
+	}
+	if err := InitKoreanFont(); err != nil {
+		return nil, err
+	}
+
+	p := theme.newPlot()
+	p.Title.Text = tr.T("timeline.title")
+	if len(days) == 1 {
+		p.Title.Text = dateLabel(tr, days[0].Date) + " " + p.Title.Text
+	}
+	p.Title.Padding = vg.Points(10)
+	p.X.Label.Text = tr.T("axis.hour")
+	p.X.Label.Padding = vg.Points(10)
+	p.Add(&timelineBlocks{days: days, theme: theme})
+
+	xticks := []plot.Tick{}
+	for h := 0; h <= 24; h++ {
+		label := ""
+		if h%3 == 0 {
+			label = fmt.Sprintf("%d", h)
+		}
+		xticks = append(xticks, plot.Tick{Value: float64(h), Label: label})
+	}
+	p.X.Tick.Marker = plot.ConstantTicks(xticks)
+	yticks := make([]plot.Tick, 0, len(days))
+	for row, day := range days {
+		yticks = append(yticks, plot.Tick{Value: float64(len(days) - 1 - row), Label: dateLabel(tr, day.Date)})
+	}
+	p.Y.Tick.Marker = plot.ConstantTicks(yticks)
+	p.Y.LineStyle.Width = 0
+	p.Y.Tick.LineStyle.Width = 0
+
+	// 범례: 등장한 카테고리만
+	seen := map[string]bool{}
+	for _, day := range days {
+		for _, label := range day.Labels {
+			if label != "" {
+				seen[label] = true
+			}
+		}
+	}
+	cats := []string{}
+	for _, cat := range common.Categories {
+		if seen[cat] {
+			cats = append(cats, cat)
+			delete(seen, cat)
+		}
+	}
+	extra := []string{}
+	for cat := range seen {
+		extra = append(extra, cat)
+	}
+	sort.Strings(extra)
+	cats = append(cats, extra...)
+	for _, cat := range cats {
+		p.Legend.Add(tr.Category(cat), colorThumb{theme.CategoryColor(cat)})
+	}
+	p.Legend.Top = true
+	p.Legend.Left = false
+	p.Legend.Padding = vg.Points(8)
+	// 24시 오른쪽에 범례 자리 확보
+	p.X.Min = 0
+	p.X.Max = 27
+	return p, nil
+}
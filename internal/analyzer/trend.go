@@ -0,0 +1,127 @@
+package analyzer
+
+import (
+	"math"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"gonum.org/v1/gonum/stat"
+)
+
+// Anomaly: 일자 TotalFocus 이상치 구분
+type Anomaly string
+
+const (
+	AnomalyNone Anomaly = ""
+	AnomalyHigh Anomaly = "high" // 직전 기간보다 눈에 띄게 높음
+	AnomalyLow  Anomaly = "low"  // 직전 기간보다 눈에 띄게 낮음
+)
+
+// TrendOptions: 일자별 추세 계산 옵션
+// - Window: 이동 평균/기울기/이상치 기준 기간 (일, 0이면 7)
+// - Threshold: 이상치 기준 z-score 절댓값 (0이면 2)
+type TrendOptions struct {
+	Window    int
+	Threshold float64
+}
+
+// WindowDays: 기준 기간 (0이면 7)
+func (o TrendOptions) WindowDays() int {
+	if o.Window <= 0 {
+		return 7
+	}
+	return o.Window
+}
+
+func (o TrendOptions) threshold() float64 {
+	if o.Threshold <= 0 {
+		return 2
+	}
+	return o.Threshold
+}
+
+// DailyTrend: 하루치 추세 분석 결과
+// - Rolling: 당일 포함 최근 Window개 기록의 TotalFocus 평균
+// - Slope: 같은 구간 TotalFocus 회귀 기울기 (점/일, 기록 2개 미만이면 0)
+// - ZScore: 직전 Window개 기록(당일 제외) 대비 TotalFocus z-score (기록 3개 미만이거나 편차 0이면 0)
+// - Anomaly: |ZScore|가 Threshold 이상이면 high/low
+type DailyTrend struct {
+	Date       string
+	TotalFocus int
+	Rolling    float64
+	Slope      float64
+	ZScore     float64
+	Anomaly    Anomaly
+}
+
+// Trends: 날짜순 FocusData 배열 → 일자별 이동 평균, 회귀 기울기, 이상치
+// - data: 날짜 오름차순 FocusData 배열 (LoadAllFocusData 결과)
+// 반환: data와 같은 순서의 DailyTrend 배열
+func Trends(data []common.FocusData, opts TrendOptions) []DailyTrend {
+	window := opts.WindowDays()
+	out := make([]DailyTrend, len(data))
+	for i, d := range data {
+		t := DailyTrend{Date: d.Date, TotalFocus: d.TotalFocus}
+		from := i + 1 - window
+		if from < 0 {
+			from = 0
+		}
+		recent := totals(data[from : i+1])
+		t.Rolling = stat.Mean(recent, nil)
+		t.Slope = slopeOf(recent)
+
+		prevFrom := i - window
+		if prevFrom < 0 {
+			prevFrom = 0
+		}
+		if prev := totals(data[prevFrom:i]); len(prev) >= 3 {
+			mean, std := stat.MeanStdDev(prev, nil)
+			if std > 0 {
+				t.ZScore = (float64(d.TotalFocus) - mean) / std
+				switch {
+				case t.ZScore >= opts.threshold():
+					t.Anomaly = AnomalyHigh
+				case t.ZScore <= -opts.threshold():
+					t.Anomaly = AnomalyLow
+				}
+			}
+		}
+		out[i] = t
+	}
+	return out
+}
+
+// CategorySlopes: 최근 window개 기록의 카테고리별 점수 회귀 기울기 (점/일, common.Categories 전체)
+// - window: 기간 (0이면 7)
+func CategorySlopes(data []common.FocusData, window int) map[string]float64 {
+	window = TrendOptions{Window: window}.WindowDays()
+	if len(data) > window {
+		data = data[len(data)-window:]
+	}
+	slopes := make(map[string]float64, len(common.Categories))
+	for _, cat := range common.Categories {
+		ys := make([]float64, len(data))
+		for i, d := range data {
+			ys[i] = float64(d.Categories[cat])
+		}
+		slopes[cat] = slopeOf(ys)
+	}
+	return slopes
+}
+
+// totals: FocusData 배열의 TotalFocus 값
+func totals(data []common.FocusData) []float64 {
+	out := make([]float64, len(data))
+	for i, d := range data {
+		out[i] = float64(d.TotalFocus)
+	}
+	return out
+}
+
+// slopeOf: 값 배열의 회귀 기울기 (x = 일자 인덱스, 값 2개 미만이면 0)
+func slopeOf(ys []float64) float64 {
+	slope, _ := linearFit(ys)
+	if math.IsNaN(slope) {
+		return 0
+	}
+	return slope
+}
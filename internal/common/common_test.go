@@ -0,0 +1,120 @@
+package common
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// restoreCategories: 테스트가 바꾼 카테고리 정의를 기본값으로 되돌림
+func restoreCategories(t *testing.T) {
+	t.Cleanup(func() { SetCategories(DefaultCategoryDefs) })
+}
+
+func TestDefaultCategories(t *testing.T) {
+	if len(Categories) != 9 || Categories[0] != "업무" || Categories[4] != "이동" {
+		t.Errorf("기본 카테고리 순서 이상: %v", Categories)
+	}
+	if IsCounted("이동") || !IsCounted("업무") || IsCounted("없는 카테고리") {
+		t.Errorf("총점 합산 여부 이상")
+	}
+	if CategoryName("업무", "en") != "Work" || CategoryName("업무", "ko") != "업무" || CategoryName("새일", "en") != "새일" {
+		t.Errorf("표시 이름 이상")
+	}
+}
+
+// 저장소의 categories.json은 내장 기본값과 같은 정의여야 함
+func TestLoadCategories_RepoFile(t *testing.T) {
+	defs, err := LoadCategories(filepath.Join("..", "..", "categories.json"))
+	if err != nil {
+		t.Fatalf("LoadCategories 실패: %v", err)
+	}
+	if len(defs) != len(DefaultCategoryDefs) {
+		t.Fatalf("카테고리 수 = %d", len(defs))
+	}
+	for i, d := range defs {
+		want := DefaultCategoryDefs[i]
+		if d.ID != want.ID || d.Counted != want.Counted || d.Rest != want.Rest || d.Order != want.Order || d.Names["en"] != want.Names["en"] {
+			t.Errorf("%d번째 정의 = %+v, 기본값 %+v", i, d, want)
+		}
+		for c := range d.Color {
+			if math.Abs(float64(d.Color[c]-want.Color[c])) > 0.01 {
+				t.Errorf("%s 색상 = %v, 기본값 %v", d.ID, d.Color, want.Color)
+			}
+		}
+	}
+}
+
+func TestLoadCategories(t *testing.T) {
+	restoreCategories(t)
+	path := filepath.Join(t.TempDir(), "categories.json")
+	os.WriteFile(path, []byte(`[
+		{"id": "코딩", "name": "Coding", "color": "#FF0000", "order": 2},
+		{"id": "휴식", "names": {"en": "Rest"}, "color": "00ff00", "counted": false, "order": 1},
+		{"id": "독서", "color": "#0000FF", "order": 2, "rest": true}
+	]`), 0o644)
+	defs, err := LoadCategories(path)
+	if err != nil {
+		t.Fatalf("LoadCategories 실패: %v", err)
+	}
+	if err := SetCategories(defs); err != nil {
+		t.Fatalf("SetCategories 실패: %v", err)
+	}
+	if len(Categories) != 3 || Categories[0] != "휴식" || Categories[1] != "코딩" || Categories[2] != "독서" {
+		t.Errorf("Order 정렬 이상 (같은 Order는 파일 순서): %v", Categories)
+	}
+	if CategoryColors["코딩"] != [3]float32{1, 0, 0} || CategoryColors["휴식"] != [3]float32{0, 1, 0} {
+		t.Errorf("색상 이상: %v", CategoryColors)
+	}
+	if IsCounted("휴식") || !IsCounted("독서") || IsCounted("업무") {
+		t.Errorf("총점 합산 여부 이상")
+	}
+	if !IsDeepWork("코딩") || IsDeepWork("독서") || IsDeepWork("휴식") {
+		t.Errorf("딥워크 여부 이상 (rest, 총점 제외 카테고리는 빠져야 함)")
+	}
+	if CategoryName("코딩", "en") != "Coding" || CategoryName("휴식", "en") != "Rest" || CategoryName("휴식", "ko") != "휴식" {
+		t.Errorf("표시 이름 이상")
+	}
+
+	for _, bad := range []string{`[{"id": "a", "color": "#12345"}]`, `[{"id": "a", "color": "red"}]`, `{"id": "a"}`} {
+		os.WriteFile(path, []byte(bad), 0o644)
+		if _, err := LoadCategories(path); err == nil {
+			t.Errorf("LoadCategories(%s)에 에러가 없음", bad)
+		}
+	}
+	for _, bad := range [][]CategoryDef{nil, {{ID: ""}}, {{ID: "a"}, {ID: "a"}}} {
+		if err := SetCategories(bad); err == nil {
+			t.Errorf("SetCategories(%v)에 에러가 없음", bad)
+		}
+	}
+	if len(Categories) != 3 {
+		t.Errorf("잘못된 정의가 기존 정의를 바꿈: %v", Categories)
+	}
+}
+
+func TestSlotLayout(t *testing.T) {
+	for _, tc := range []struct {
+		minutes, perHour, perDay int
+		last                     string
+	}{{0, 6, 144, "23:50"}, {5, 12, 288, "23:55"}, {15, 4, 96, "23:45"}, {30, 2, 48, "23:30"}} {
+		l, err := NewSlotLayout(tc.minutes)
+		if err != nil {
+			t.Fatalf("NewSlotLayout(%d) 에러: %v", tc.minutes, err)
+		}
+		if l.PerHour() != tc.perHour || l.PerDay() != tc.perDay || l.Key(l.PerDay()-1) != tc.last {
+			t.Errorf("%d분: PerHour=%d PerDay=%d 마지막=%s", tc.minutes, l.PerHour(), l.PerDay(), l.Key(l.PerDay()-1))
+		}
+	}
+	if key := (SlotLayout{Minutes: 15}).Key(37); key != "09:15" {
+		t.Errorf("Key(37) = %s, want 09:15", key)
+	}
+	for _, bad := range []int{7, 20, 60, -5} {
+		if _, err := NewSlotLayout(bad); err == nil {
+			t.Errorf("NewSlotLayout(%d)에 에러가 없음", bad)
+		}
+	}
+	if SlotMinutesOf(FocusData{}) != DefaultSlotMinutes || SlotMinutesOf(FocusData{SlotMinutes: 30}) != 30 {
+		t.Errorf("SlotMinutesOf 이상")
+	}
+}
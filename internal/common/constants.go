@@ -1,20 +1,177 @@
 package common
 
-// Categories: 집중도 분석에 사용되는 공통 카테고리
-// - 업무, 학습, 취미, 수면, 이동 순서로 고정
-var Categories = []string{"업무", "학습", "취미", "수면", "이동", "봉사", "기타", "운동", "스터디"}
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
 
-// CategoryColors: 카테고리별 Google Sheets 색상(RGB 0~1)
-// - 카테고리 추가 시 이 맵에 색상도 같이 추가할 것
+// CategoryDef: 카테고리 정의 하나 (카테고리 설정 파일의 항목)
+// - ID: 데이터(JSON, 시트 드롭다운)에 쓰는 키
+// - Name: 표시 이름 (비면 ID), Names: 언어별 표시 이름 (예: {"en": "Work"}, Name보다 우선)
+// - Color: Google Sheets/그래프 색상 (RGB 0~1)
+// - Counted: TotalFocus 합산 여부
+// - Rest: 수면처럼 점수는 높아도 집중 활동이 아닌 카테고리 (딥워크 시간에서 제외)
+// - Order: 드롭다운/그래프/표 순서 (작은 값 먼저, 같으면 정의 순서)
+type CategoryDef struct {
+	ID      string
+	Name    string
+	Names   map[string]string
+	Color   [3]float32
+	Counted bool
+	Rest    bool
+	Order   int
+}
+
+// DefaultCategoryDefs: 설정 파일이 없을 때 쓰는 기본 카테고리 ("이동"은 총점 제외)
+var DefaultCategoryDefs = []CategoryDef{
+	{ID: "업무", Names: map[string]string{"en": "Work"}, Color: [3]float32{0.8, 0.9, 1.0}, Counted: true, Order: 1},              // 연한 파랑
+	{ID: "학습", Names: map[string]string{"en": "Study"}, Color: [3]float32{0.8, 1.0, 0.8}, Counted: true, Order: 2},             // 연한 초록
+	{ID: "취미", Names: map[string]string{"en": "Hobby"}, Color: [3]float32{1.0, 0.9, 0.8}, Counted: true, Order: 3},             // 연한 주황
+	{ID: "수면", Names: map[string]string{"en": "Sleep"}, Color: [3]float32{0.9, 0.8, 1.0}, Counted: true, Rest: true, Order: 4}, // 연한 보라
+	{ID: "이동", Names: map[string]string{"en": "Commute"}, Color: [3]float32{1.0, 1.0, 0.8}, Counted: false, Order: 5},          // 연한 노랑
+	{ID: "봉사", Names: map[string]string{"en": "Volunteer"}, Color: [3]float32{0.8, 1.0, 1.0}, Counted: true, Order: 6},         // 연한 청록
+	{ID: "기타", Names: map[string]string{"en": "Other"}, Color: [3]float32{1.0, 0.8, 1.0}, Counted: true, Order: 7},             // 연한 핑크
+	{ID: "운동", Names: map[string]string{"en": "Exercise"}, Color: [3]float32{1.0, 0.8, 0.8}, Counted: true, Order: 8},          // 연한 빨강
+	{ID: "스터디", Names: map[string]string{"en": "Study group"}, Color: [3]float32{0.8, 0.8, 1.0}, Counted: true, Order: 9},      // 연한 남색
+}
+
+// CategoryDefs: 현재 카테고리 정의 (Order 순, SetCategories로 교체)
+var CategoryDefs []CategoryDef
+
+// Categories: 집중도 분석에 사용되는 공통 카테고리 ID (CategoryDefs 순서)
+var Categories []string
+
+// CategoryColors: 카테고리별 Google Sheets/그래프 색상(RGB 0~1) (CategoryDefs에서 만듦)
 // - 예시: CategoryColors["업무"]
-var CategoryColors = map[string][3]float32{
-	"업무":  {0.8, 0.9, 1.0}, // 연한 파랑
-	"학습":  {0.8, 1.0, 0.8}, // 연한 초록
-	"취미":  {1.0, 0.9, 0.8}, // 연한 주황
-	"수면":  {0.9, 0.8, 1.0}, // 연한 보라
-	"이동":  {1.0, 1.0, 0.8}, // 연한 노랑
-	"봉사":  {0.8, 1.0, 1.0}, // 연한 청록
-	"기타":  {1.0, 0.8, 1.0}, // 연한 핑크
-	"운동":  {1.0, 0.8, 0.8}, // 연한 빨강
-	"스터디": {0.8, 0.8, 1.0}, // 연한 남색
+var CategoryColors map[string][3]float32
+
+func init() {
+	if err := SetCategories(DefaultCategoryDefs); err != nil {
+		panic(err)
+	}
+}
+
+// SetCategories: 카테고리 정의를 검증해 Order 순으로 정렬하고 Categories/CategoryColors를 다시 만듦
+// 반환: 에러 (정의 없음, 빈 ID, 중복 ID)
+func SetCategories(defs []CategoryDef) error {
+	if len(defs) == 0 {
+		return fmt.Errorf("카테고리 정의가 비어 있습니다")
+	}
+	sorted := append([]CategoryDef{}, defs...)
+	seen := map[string]bool{}
+	for _, d := range sorted {
+		if strings.TrimSpace(d.ID) == "" {
+			return fmt.Errorf("카테고리 ID가 비어 있습니다")
+		}
+		if seen[d.ID] {
+			return fmt.Errorf("카테고리 ID가 중복됩니다: %q", d.ID)
+		}
+		seen[d.ID] = true
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Order < sorted[j].Order })
+
+	CategoryDefs = sorted
+	Categories = make([]string, 0, len(sorted))
+	CategoryColors = make(map[string][3]float32, len(sorted))
+	for _, d := range sorted {
+		Categories = append(Categories, d.ID)
+		CategoryColors[d.ID] = d.Color
+	}
+	return nil
+}
+
+// LookupCategory: ID의 카테고리 정의
+func LookupCategory(id string) (CategoryDef, bool) {
+	for _, d := range CategoryDefs {
+		if d.ID == id {
+			return d, true
+		}
+	}
+	return CategoryDef{}, false
+}
+
+// IsCounted: TotalFocus에 합산하는 카테고리인지 (정의에 없으면 false)
+func IsCounted(id string) bool {
+	d, ok := LookupCategory(id)
+	return ok && d.Counted
+}
+
+// IsDeepWork: 딥워크 시간에 넣는 카테고리인지 (총점 합산 + Rest 아님, 정의에 없으면 false)
+func IsDeepWork(id string) bool {
+	d, ok := LookupCategory(id)
+	return ok && d.Counted && !d.Rest
+}
+
+// CategoryName: 카테고리 ID → locale 표시 이름 (Names[locale] → Name → ID 순)
+func CategoryName(id, locale string) string {
+	d, ok := LookupCategory(id)
+	if !ok {
+		return id
+	}
+	if name := d.Names[locale]; name != "" {
+		return name
+	}
+	if d.Name != "" {
+		return d.Name
+	}
+	return id
+}
+
+// categoryEntry: 카테고리 설정 파일 항목
+// - color: "#RRGGBB"
+// - counted: 생략하면 true
+// - rest: 생략하면 false
+// - order: 생략하면 파일 순서
+type categoryEntry struct {
+	ID      string            `json:"id"`
+	Name    string            `json:"name,omitempty"`
+	Names   map[string]string `json:"names,omitempty"`
+	Color   string            `json:"color"`
+	Counted *bool             `json:"counted,omitempty"`
+	Rest    bool              `json:"rest,omitempty"`
+	Order   *int              `json:"order,omitempty"`
+}
+
+// LoadCategories: 카테고리 설정 파일(JSON 배열) 읽기
+// - 예: [{"id": "업무", "names": {"en": "Work"}, "color": "#CCE6FF"}, {"id": "이동", "color": "#FFFFCC", "counted": false}]
+// 반환: 정의 목록 (SetCategories에 넘길 수 있음), 에러
+func LoadCategories(path string) ([]CategoryDef, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("카테고리 설정 읽기 실패: %w", err)
+	}
+	var entries []categoryEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("카테고리 설정 파싱 실패 (%s): %w", path, err)
+	}
+	defs := make([]CategoryDef, 0, len(entries))
+	for i, e := range entries {
+		color, err := ParseColor(e.Color)
+		if err != nil {
+			return nil, fmt.Errorf("카테고리 %q: %w", e.ID, err)
+		}
+		d := CategoryDef{ID: e.ID, Name: e.Name, Names: e.Names, Color: color, Counted: true, Rest: e.Rest, Order: i + 1}
+		if e.Counted != nil {
+			d.Counted = *e.Counted
+		}
+		if e.Order != nil {
+			d.Order = *e.Order
+		}
+		defs = append(defs, d)
+	}
+	return defs, nil
+}
+
+// ParseColor: "#RRGGBB" → RGB 0~1
+func ParseColor(s string) ([3]float32, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(s), "#")
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return [3]float32{}, fmt.Errorf("색상 형식 오류: %q (#RRGGBB)", s)
+	}
+	return [3]float32{float32(v>>16&0xff) / 255, float32(v>>8&0xff) / 255, float32(v&0xff) / 255}, nil
 }
@@ -0,0 +1,65 @@
+package common
+
+import "fmt"
+
+// DefaultSlotMinutes: 슬롯 길이 기본값 (분)
+// - 슬롯 길이 기록이 없는 이전 스프레드시트/JSON도 이 값으로 봄
+const DefaultSlotMinutes = 10
+
+// MaxSlotScore: 슬롯 하나의 최대 집중도 점수 (시트 Focus 칸 0~5, MaxScore는 슬롯마다 이만큼 더함)
+const MaxSlotScore = 5
+
+// SlotMinuteChoices: 허용하는 슬롯 길이 (분, 1시간을 나누어떨어지는 값만)
+var SlotMinuteChoices = []int{5, 10, 15, 30}
+
+// SlotLayout: 월별 시트의 시간 슬롯 구성 (행 하나 = Minutes분)
+// - Minutes가 0이면 DefaultSlotMinutes
+type SlotLayout struct {
+	Minutes int
+}
+
+// DefaultSlotLayout: 기본 슬롯 구성 (10분, 하루 144칸)
+var DefaultSlotLayout = SlotLayout{Minutes: DefaultSlotMinutes}
+
+// NewSlotLayout: 슬롯 길이 → SlotLayout (0이면 기본값)
+// 반환: 구성, 에러 (SlotMinuteChoices에 없는 값)
+func NewSlotLayout(minutes int) (SlotLayout, error) {
+	if minutes == 0 {
+		return DefaultSlotLayout, nil
+	}
+	for _, m := range SlotMinuteChoices {
+		if m == minutes {
+			return SlotLayout{Minutes: minutes}, nil
+		}
+	}
+	return SlotLayout{}, fmt.Errorf("지원하지 않는 슬롯 길이: %d분 (%v 중 하나)", minutes, SlotMinuteChoices)
+}
+
+// SlotMinutes: 슬롯 길이 (분, 0이면 DefaultSlotMinutes)
+func (l SlotLayout) SlotMinutes() int {
+	if l.Minutes <= 0 {
+		return DefaultSlotMinutes
+	}
+	return l.Minutes
+}
+
+// PerHour: 1시간의 슬롯(행) 수
+func (l SlotLayout) PerHour() int {
+	return 60 / l.SlotMinutes()
+}
+
+// PerDay: 하루의 슬롯(행) 수
+func (l SlotLayout) PerDay() int {
+	return 24 * l.PerHour()
+}
+
+// Key: i번째 슬롯의 시작 시각 키 (예: 10분 슬롯 57 → "09:30", FocusData.TimeSlots 키와 같은 형식)
+func (l SlotLayout) Key(i int) string {
+	start := i * l.SlotMinutes()
+	return fmt.Sprintf("%02d:%02d", start/60, start%60)
+}
+
+// SlotMinutesOf: FocusData의 슬롯 길이 (기록이 없는 이전 JSON은 DefaultSlotMinutes)
+func SlotMinutesOf(d FocusData) int {
+	return SlotLayout{Minutes: d.SlotMinutes}.SlotMinutes()
+}
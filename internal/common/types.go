@@ -1,9 +1,22 @@
 package common
 
+// FocusData: 하루치 집계 결과 (dailydata/raw JSON 형식)
+// - TimeSlots 키는 시트 행 위치 기준 시작 시각 (빈 칸은 키 없음)
+// - 이전 버전으로 추출한 JSON은 빈 칸을 빼고 당긴 순서로 키를 매겨 빈 칸이 있던 날은 키가 실제 시각보다 이름 (합계는 같음, 다시 extract하면 갱신)
 type FocusData struct {
-	Date       string            `json:"date"`
-	TotalFocus int               `json:"totalFocus"`
-	MaxScore   map[string]int    `json:"maxScore"`
-	Categories map[string]int    `json:"categories"`
-	TimeSlots  map[string]int    `json:"timeSlots"`
+	Date        string            `json:"date"`
+	TotalFocus  int               `json:"totalFocus"`
+	MaxScore    map[string]int    `json:"maxScore"`
+	Categories  map[string]int    `json:"categories"`
+	TimeSlots   map[string]int    `json:"timeSlots"`
+	SlotLabels  map[string]string `json:"slotLabels,omitempty"`  // 시간대별 카테고리 (ex: "09:30" -> "업무")
+	SlotMinutes int               `json:"slotMinutes,omitempty"` // 슬롯 길이 (분, 없으면 DefaultSlotMinutes)
+}
+
+// DaySlots: 하루치 시트 슬롯 원본 (SlotMinutes분 단위 하루치 칸, 빈 칸은 Label "" / Score 0)
+type DaySlots struct {
+	Date        string
+	Labels      []string
+	Scores      []int
+	SlotMinutes int // 슬롯 길이 (분, 0이면 DefaultSlotMinutes)
 }
@@ -3,10 +3,15 @@ package config
 import (
 	"encoding/base64"
 	"os"
+	"strconv"
 
+	"github.com/crispy/focus-time-tracker/internal/common"
 	"github.com/joho/godotenv"
 )
 
+// DefaultCategoriesFile: CATEGORIES_FILE이 없을 때 찾는 카테고리 설정 파일 (없으면 내장 기본값)
+const DefaultCategoriesFile = "categories.json"
+
 type Env struct {
 	GSheetsCredentialsJSON string
 	GSheetsParentFolderID  string
@@ -14,6 +19,47 @@ type Env struct {
 	GH_TOKEN               string
 	RepoDownloadPath       string
 	GitbookRepoPath        string
+	ChartFormat            string // 그래프 출력 포맷 (png, svg, pdf, eps)
+	ChartWidth             int    // 그래프 너비 (pt, 0이면 기본값)
+	ChartHeight            int    // 그래프 높이 (pt, 0이면 기본값)
+	ChartDPI               int    // PNG 해상도 (0이면 기본값)
+	ChartTheme             string // 그래프 테마 (light, dark)
+	ChartNoWatermark       bool   // 그래프 워터마크(생성 시각) 생략 여부
+	Locale                 string // 그래프/CLI/대시보드 표시 언어 (ko, en, 비면 ko)
+	KoreanFontPath         string // 그래프 한글 폰트 파일 (비면 내장 D2Coding)
+	DashboardPanels        string // 대시보드 패널 (쉼표 구분, 비면 trends,timeslot,share,heatmap)
+	DashboardColumns       int    // 대시보드 한 줄 패널 수 (0이면 2)
+	CalendarCategory       string // 달력 히트맵 카테고리 (비면 TotalFocus)
+	HeatmapCategory        string // 요일×시간대 히트맵 카테고리 필터 (비면 전체)
+	HeatmapHourly          bool   // 요일×시간대 히트맵 1시간 단위 여부 (false면 10분 단위)
+	PublishMode            string // 게시 방식 (push: main 직접 push, pr: 날짜 브랜치 PR)
+	GitHubAPIURL           string // GitHub REST API 주소 (비면 https://api.github.com)
+	PRAutoMerge            bool   // PR 게시 시 체크 통과 후 자동 머지 여부
+	PRMergeMethod          string // 자동 머지 방식 (merge, squash, rebase, 비면 squash)
+	PRCheckTimeout         int    // 자동 머지 전 체크 대기 시간 (분, 0이면 30)
+	PRCheckGrace           int    // push 후 체크가 하나도 없을 때 등록을 기다리는 시간 (분, 0이면 2)
+	PRRequiredChecks       string // 자동 머지 전 반드시 통과해야 하는 체크 이름 (쉼표 구분, 비면 등록된 체크 전체)
+	Publisher              string // 게시 백엔드 (git, local, dry-run, 비면 git)
+	PublishLocalDir        string // local 게시 백엔드의 복사 대상 디렉토리
+	NotifyWebhookURL       string // 일간 요약 일반 webhook 주소 (비면 사용 안 함)
+	SlackWebhookURL        string // 일간 요약 Slack incoming webhook 주소 (비면 사용 안 함)
+	SMTPHost               string // 일간 요약 메일 SMTP 서버 (비면 사용 안 함)
+	SMTPPort               int    // SMTP 포트 (0이면 587)
+	SMTPUsername           string // SMTP 인증 사용자 (비면 인증 없음)
+	SMTPPassword           string // SMTP 인증 비밀번호
+	SMTPFrom               string // 보내는 사람
+	SMTPTo                 string // 받는 사람 (쉼표 구분)
+	NotifyTemplateFile     string // 알림 본문 템플릿 파일 (비면 언어별 기본 템플릿)
+	NotifyImageURL         string // 알림에 넣을 그래프 공개 주소 (Slack 이미지 블록)
+	FeedMaxEntries         int    // 리포트 Atom 피드 항목 수 (0이면 30)
+	FeedBaseURL            string // 리포트 피드 링크 기준 주소 (reports/ 공개 주소, 비면 상대 경로)
+	Retention              string // dailydata 이미지 보존 정책 (예: 30d,12w,12m, 비면 기본값)
+	PruneAfterExtract      bool   // extract 후 보존 정책으로 오래된 이미지 자동 정리 여부
+	CategoriesFile         string // 카테고리 설정 파일 (비면 categories.json, 그것도 없으면 내장 기본값)
+	SlotMinutes            int    // 새로 만드는 스프레드시트의 슬롯 길이 (분: 5, 10, 15, 30, 0이면 10)
+	AnalysisWriteBack      bool   // extract 후 스프레드시트 분석 탭에 결과를 쓸지 여부
+	AnalysisWindow         int    // 분석 탭 이동 평균/기울기/이상치 기준 기간 (일, 0이면 7)
+	AnalysisImageURL       string // 분석 탭에 넣을 최신 그래프 공개 주소 (비면 NOTIFY_IMAGE_URL, 그것도 없으면 생략)
 	// 필요한 항목 추가 가능
 }
 
@@ -37,5 +83,86 @@ func LoadEnv() {
 		GH_TOKEN:               os.Getenv("GH_TOKEN"),
 		RepoDownloadPath:       os.Getenv("REPO_DOWNLOAD_PATH"),
 		GitbookRepoPath:        os.Getenv("GITBOOK_REPO_PATH"),
+		ChartFormat:            os.Getenv("CHART_FORMAT"),
+		ChartWidth:             getEnvInt("CHART_WIDTH"),
+		ChartHeight:            getEnvInt("CHART_HEIGHT"),
+		ChartDPI:               getEnvInt("CHART_DPI"),
+		ChartTheme:             os.Getenv("CHART_THEME"),
+		ChartNoWatermark:       getEnvBool("CHART_NO_WATERMARK", false),
+		Locale:                 os.Getenv("LOCALE"),
+		KoreanFontPath:         os.Getenv("KOREAN_FONT_PATH"),
+		DashboardPanels:        os.Getenv("DASHBOARD_PANELS"),
+		DashboardColumns:       getEnvInt("DASHBOARD_COLUMNS"),
+		CalendarCategory:       os.Getenv("CALENDAR_CATEGORY"),
+		HeatmapCategory:        os.Getenv("HEATMAP_CATEGORY"),
+		HeatmapHourly:          getEnvBool("HEATMAP_HOURLY", true),
+		PublishMode:            os.Getenv("PUBLISH_MODE"),
+		GitHubAPIURL:           os.Getenv("GITHUB_API_URL"),
+		PRAutoMerge:            getEnvBool("PR_AUTO_MERGE", false),
+		PRMergeMethod:          os.Getenv("PR_MERGE_METHOD"),
+		PRCheckTimeout:         getEnvInt("PR_CHECK_TIMEOUT"),
+		PRCheckGrace:           getEnvInt("PR_CHECK_GRACE"),
+		PRRequiredChecks:       os.Getenv("PR_REQUIRED_CHECKS"),
+		Publisher:              os.Getenv("PUBLISHER"),
+		PublishLocalDir:        os.Getenv("PUBLISH_LOCAL_DIR"),
+		NotifyWebhookURL:       os.Getenv("NOTIFY_WEBHOOK_URL"),
+		SlackWebhookURL:        os.Getenv("SLACK_WEBHOOK_URL"),
+		SMTPHost:               os.Getenv("SMTP_HOST"),
+		SMTPPort:               getEnvInt("SMTP_PORT"),
+		SMTPUsername:           os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:           os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:               os.Getenv("SMTP_FROM"),
+		SMTPTo:                 os.Getenv("SMTP_TO"),
+		NotifyTemplateFile:     os.Getenv("NOTIFY_TEMPLATE_FILE"),
+		NotifyImageURL:         os.Getenv("NOTIFY_IMAGE_URL"),
+		FeedMaxEntries:         getEnvInt("FEED_MAX_ENTRIES"),
+		FeedBaseURL:            os.Getenv("FEED_BASE_URL"),
+		Retention:              os.Getenv("RETENTION"),
+		PruneAfterExtract:      getEnvBool("PRUNE_AFTER_EXTRACT", false),
+		CategoriesFile:         os.Getenv("CATEGORIES_FILE"),
+		SlotMinutes:            getEnvInt("SLOT_MINUTES"),
+		AnalysisWriteBack:      getEnvBool("ANALYSIS_WRITEBACK", false),
+		AnalysisWindow:         getEnvInt("ANALYSIS_WINDOW"),
+		AnalysisImageURL:       os.Getenv("ANALYSIS_IMAGE_URL"),
+	}
+}
+
+// LoadCategories: 카테고리 설정 파일을 읽어 common 카테고리 정의로 적용
+// - CATEGORIES_FILE을 지정했는데 파일이 없으면 에러, 기본 경로 파일이 없으면 내장 기본값 유지
+func LoadCategories() error {
+	path := Envs.CategoriesFile
+	if path == "" {
+		if _, err := os.Stat(DefaultCategoriesFile); err != nil {
+			return nil
+		}
+		path = DefaultCategoriesFile
+	}
+	defs, err := common.LoadCategories(path)
+	if err != nil {
+		return err
+	}
+	return common.SetCategories(defs)
+}
+
+// SlotLayout: SLOT_MINUTES로 새 스프레드시트 슬롯 구성 (기존 스프레드시트는 시트에 기록된 값을 씀)
+func SlotLayout() (common.SlotLayout, error) {
+	return common.NewSlotLayout(Envs.SlotMinutes)
+}
+
+// getEnvBool: 불리언 환경변수 읽기 (없거나 파싱 실패 시 def)
+func getEnvBool(key string, def bool) bool {
+	v, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// getEnvInt: 정수 환경변수 읽기 (없거나 숫자가 아니면 0)
+func getEnvInt(key string) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return 0
 	}
+	return v
 }
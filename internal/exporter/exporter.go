@@ -2,25 +2,58 @@ package exporter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"os/exec"
+	"log"
+	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"github.com/crispy/focus-time-tracker/internal/report"
 	"github.com/crispy/focus-time-tracker/internal/sheets"
+	"github.com/crispy/focus-time-tracker/internal/site"
 	drivev3 "google.golang.org/api/drive/v3"
 	sheetsv4 "google.golang.org/api/sheets/v4"
 )
 
+// ExtractOptions: Extract 부가 옵션
+// - Render: 그래프 출력 포맷/크기/DPI, 언어 (파일 확장자도 포맷을 따름, 언어는 HTML 대시보드에도 적용)
+// - CalendarCategory: 달력 히트맵에 쓸 카테고리 (비면 TotalFocus)
+// - Heatmap: 요일×시간대 히트맵 옵션 (카테고리 필터, 해상도)
+// - Dashboard: 대시보드 이미지 패널/배치
+// - Feed: 리포트 Atom 피드 항목 수/링크 기준 주소
+// - Prune: 추출 후 dailydata 이미지에 적용할 보존 정책 (nil이면 정리하지 않음)
+// - ManifestPath: 산출물 목록 저장 경로 (비면 ManifestFile)
+// - DryRun: 미리보기 실행 (보존 정책 정리는 파일을 지우지 않고 삭제 대상만 Pruned에 기록)
+type ExtractOptions struct {
+	Render           analyzer.RenderOptions
+	CalendarCategory string
+	Heatmap          analyzer.WeekdayHeatmapOptions
+	Dashboard        analyzer.DashboardOptions
+	Feed             report.FeedOptions
+	Prune            *RetentionPolicy
+	ManifestPath     string
+	DryRun           bool
+}
+
 // Extract: 집중도 데이터 추출~저장~그래프 생성까지 수행, push는 하지 않음
-// 반환: dateStr, jsonRelPath, commitMsg, error
-func Extract(ctx context.Context, sheetsSrv *sheetsv4.Service, driveSrv *drivev3.Service, folderID, repoPath string, repoDownloadPath string, now time.Time) (string, string, string, error) {
+// - opts: 그래프 렌더/달력 옵션
+// 반환: 생성한 산출물 목록 (ManifestPath에도 저장), error
+func Extract(ctx context.Context, sheetsSrv *sheetsv4.Service, driveSrv *drivev3.Service, folderID, repoPath string, repoDownloadPath string, now time.Time, opts ExtractOptions) (*Manifest, error) {
 	// 1. 한국 시간으로 변환
 	loc, err := time.LoadLocation("Asia/Seoul")
 	if err != nil {
-		return "", "", "", fmt.Errorf("Asia/Seoul 타임존 로드 실패: %w", err)
+		return nil, fmt.Errorf("Asia/Seoul 타임존 로드 실패: %w", err)
 	}
 	now = now.In(loc)
+	if opts.Render.Now.IsZero() {
+		opts.Render.Now = now
+	}
 
 	// 2. 어제 날짜 계산
 	yesterday := now.AddDate(0, 0, -1)
@@ -29,59 +62,329 @@ func Extract(ctx context.Context, sheetsSrv *sheetsv4.Service, driveSrv *drivev3
 	// 3. Google Sheets에서 해당 연도 스프레드시트 ID 찾기
 	spreadsheetID, err := sheets.FindSpreadsheetIDByYear(ctx, driveSrv, folderID, year)
 	if err != nil {
-		return "", "", "", fmt.Errorf("스프레드시트 ID 검색 실패: %w", err)
+		return nil, fmt.Errorf("스프레드시트 ID 검색 실패: %w", err)
 	}
 
-	// 4. 어제 날짜의 집중도 데이터 추출
-	data, dateStr, err := sheets.ExtractDailyFocusData(sheetsSrv, spreadsheetID, year, int(month), day)
+	// 4. 어제 날짜의 슬롯 원본 추출 및 집중도 집계
+	slots, err := sheets.ExtractDailySlots(sheetsSrv, spreadsheetID, year, int(month), day)
 	if err != nil {
-		return "", "", "", fmt.Errorf("시트 데이터 파싱 실패: %w", err)
+		return nil, fmt.Errorf("시트 데이터 파싱 실패: %w", err)
 	}
+	data := analyzer.AnalyzeDaySlots(slots)
+	dateStr := slots.Date
 
 	// 5. JSON 파일로 저장
 	jsonRelPath := filepath.Join("dailydata", "raw", dateStr+".json")
 	commitMsg := "자동 집중도 데이터: " + dateStr
 	if err := SaveJSON(data, jsonRelPath); err != nil {
-		return "", "", "", err
+		return nil, err
+	}
+	run := &extractRun{
+		m:         NewManifest(dateStr, jsonRelPath, commitMsg, repoPath, now),
+		opts:      opts,
+		render:    opts.Render,
+		ext:       opts.Render.Format.Ext(),
+		repoPath:  repoPath,
+		assetsDir: repoDownloadPath,
+		date:      dateStr,
+		now:       now,
+	}
+	run.m.AssetsDir = repoDownloadPath
+	if err := run.add(RepoMain, KindData, jsonRelPath); err != nil {
+		return nil, err
 	}
 
-	// 6. 최근 7일치 데이터 로드
-	allData, err := LoadRecentFocusData(filepath.Join("dailydata", "raw"), 7)
+	// 6. 전체 기록 로드 (한 번만 읽고 기간별로 잘라 씀)
+	history, err := LoadAllFocusData(filepath.Join("dailydata", "raw"))
 	if err != nil {
-		return "", "", "", err
+		return nil, err
 	}
 
-	// 7. 그래프 이미지 생성 (gitbook, dailydata)
-	if len(allData) > 0 {
-		graphGitbook := filepath.Join(repoPath, repoDownloadPath, "graph.png")
-		graphDaily := filepath.Join("dailydata", "images", dateStr+".png")
-		if err := GenerateGraphFile(allData, graphGitbook, graphDaily); err != nil {
-			return "", "", "", err
+	// 7. 그래프/리포트 생성 (manifest 순서 = 생성 순서)
+	for _, step := range []func() error{
+		func() error { return run.saveTrendGraphs(lastDays(history, 7)) },
+		func() error { return run.saveTimeline(slots) },
+		func() error { return run.saveMonthlyGraphs(lastDays(history, 30)) },
+		func() error { return run.saveCalendar(history, year) },
+		func() error { return run.saveHistoryArtifacts(history) },
+	} {
+		if err := step(); err != nil {
+			return nil, err
 		}
-		// 일자별 시간대별 몰입 그래프 저장
-		timeslotGitbook := filepath.Join(repoPath, repoDownloadPath, "timeslot-images.png")
-		timeslotDaily := filepath.Join("dailydata", "timeslot-images", dateStr+".png")
-		if err := SaveTimeSlotGraphs(allData, timeslotGitbook, timeslotDaily); err != nil {
-			return "", "", "", err
+	}
+
+	// 8. 보존 정책에 따라 오래된 날짜별 이미지 정리 (기준일은 추출 날짜, raw JSON은 대상 아님)
+	if opts.Prune != nil {
+		pruned, err := Prune(PruneDirs, *opts.Prune, yesterday, opts.DryRun)
+		if err != nil {
+			return nil, err
 		}
+		run.m.Pruned = pruned
+	}
+
+	// 9. 산출물 목록 저장 (별도 focus push 실행이 읽음)
+	manifestPath := opts.ManifestPath
+	if manifestPath == "" {
+		manifestPath = ManifestFile
 	}
+	if err := run.m.Save(manifestPath); err != nil {
+		return nil, err
+	}
+	return run.m, nil
+}
+
+// extractRun: Extract 한 번의 산출물 생성 상태 (저장한 파일을 manifest에 기록)
+// - assetsDir: gitbook repo 안의 그래프 디렉토리 (repoPath 기준)
+// - date: 추출 날짜 (YYYY-MM-DD, 날짜별 파일 이름)
+type extractRun struct {
+	m         *Manifest
+	opts      ExtractOptions
+	render    analyzer.RenderOptions
+	ext       string
+	repoPath  string
+	assetsDir string
+	date      string
+	now       time.Time
+}
 
-	return dateStr, jsonRelPath, commitMsg, nil
+// add: 저장한 파일들을 manifest에 기록
+func (r *extractRun) add(repo ArtifactRepo, kind ArtifactKind, paths ...string) error {
+	for _, p := range paths {
+		if err := r.m.Add(repo, kind, p); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Push: gitbook repo checkout, push, main repo push
-func Push(repoPath, dateStr, jsonRelPath, commitMsg string) error {
-	// 8. gitbook repo main 브랜치로 checkout
-	exec.Command("git", "-C", repoPath, "checkout", "main").Run()
+// asset: gitbook 그래프 디렉토리 안의 name+확장자 경로
+func (r *extractRun) asset(name string) string {
+	return filepath.Join(r.repoPath, r.assetsDir, name+r.ext)
+}
 
-	// 9. gitbook repo에 그래프 push
-	if err := PushGitbookAssets(repoPath, commitMsg); err != nil {
+// saveTrendGraphs: 최근 기록의 트렌드/회귀선, 시간대별 평균 그래프 (gitbook 최신본 + 날짜별 사본)
+func (r *extractRun) saveTrendGraphs(recent []common.FocusData) error {
+	if len(recent) == 0 {
+		return nil
+	}
+	graphGitbook := r.asset("graph")
+	graphDaily := filepath.Join("dailydata", "images", r.date+r.ext)
+	if err := GenerateGraphFile(recent, r.render, graphGitbook, graphDaily); err != nil {
 		return err
 	}
+	timeslotGitbook := r.asset("timeslot-images")
+	timeslotDaily := filepath.Join("dailydata", "timeslot-images", r.date+r.ext)
+	if err := SaveTimeSlotGraphs(recent, r.render, timeslotGitbook, timeslotDaily); err != nil {
+		return err
+	}
+	return firstErr(
+		r.add(RepoGitbook, KindGraph, graphGitbook),
+		r.add(RepoMain, KindGraph, graphDaily),
+		r.add(RepoGitbook, KindTimeSlot, timeslotGitbook),
+		r.add(RepoMain, KindTimeSlot, timeslotDaily),
+	)
+}
 
-	// 10. main repo에 데이터/이미지 push
-	if err := PushMainAssets(dateStr, jsonRelPath, commitMsg); err != nil {
+// saveTimeline: 추출한 하루 타임라인 (JSON은 합계만 남으므로 시트 슬롯 원본으로 그림)
+func (r *extractRun) saveTimeline(slots common.DaySlots) error {
+	timelineDaily := filepath.Join("dailydata", "timeline-images", r.date+r.ext)
+	if err := SaveDailyTimeline([]common.DaySlots{slots}, r.render, timelineDaily); err != nil {
+		return err
+	}
+	return r.add(RepoMain, KindTimeline, timelineDaily)
+}
+
+// saveMonthlyGraphs: 최근 기록의 카테고리 누적 영역, 분포 박스 플롯, 대시보드 이미지 (gitbook)
+func (r *extractRun) saveMonthlyGraphs(month []common.FocusData) error {
+	if len(month) == 0 {
+		return nil
+	}
+	dir := filepath.Join(r.repoPath, r.assetsDir)
+	if err := SaveCategoryShareGraphs(month, r.render, dir); err != nil {
+		return err
+	}
+	if err := SaveDistributionGraphs(month, r.render, dir); err != nil {
+		return err
+	}
+	dashboardGitbook := r.asset("dashboard")
+	if err := SaveDashboard(month, r.opts.Dashboard, r.render, dashboardGitbook); err != nil {
+		return err
+	}
+	return firstErr(
+		r.add(RepoGitbook, KindShare, r.asset("category-share"), r.asset("category-share-normalized")),
+		r.add(RepoGitbook, KindBoxPlot, r.asset("category-boxplot"), r.asset("timeslot-boxplot")),
+		r.add(RepoGitbook, KindDashboard, dashboardGitbook),
+	)
+}
+
+// saveCalendar: year 달력 히트맵 (gitbook, 다른 연도 기록은 무시됨)
+func (r *extractRun) saveCalendar(history []common.FocusData, year int) error {
+	calRender := r.render
+	calRender.Height = r.render.Width / 4
+	cal := analyzer.CalendarOptions{Year: year, Category: r.opts.CalendarCategory}
+	calendarGitbook := r.asset("calendar")
+	if err := SaveCalendarHeatmap(history, cal, calRender, calendarGitbook); err != nil {
+		return err
+	}
+	return r.add(RepoGitbook, KindCalendar, calendarGitbook)
+}
+
+// saveHistoryArtifacts: 전체 기록으로 요일×시간대 히트맵, HTML 대시보드, 일간/주간 리포트(목차/피드 포함) 생성
+func (r *extractRun) saveHistoryArtifacts(history []common.FocusData) error {
+	if len(history) == 0 {
+		return nil
+	}
+	heatmapGitbook := r.asset("weekday-heatmap")
+	if err := SaveWeekdayHeatmap(history, r.opts.Heatmap, r.render, heatmapGitbook); err != nil {
+		return err
+	}
+	siteGitbook := filepath.Join(r.repoPath, r.assetsDir, site.FileName)
+	if err := site.Generate(history, siteGitbook, r.now, i18n.New(r.render.Locale)); err != nil {
+		return err
+	}
+	reportOpts := report.Options{RepoPath: r.repoPath, AssetsDir: r.assetsDir, Render: r.render, Feed: r.opts.Feed}
+	written, err := report.Generate(history, r.date, reportOpts)
+	if err != nil {
+		return err
+	}
+	if err := firstErr(
+		r.add(RepoGitbook, KindHeatmap, heatmapGitbook),
+		r.add(RepoGitbook, KindSite, siteGitbook),
+	); err != nil {
+		return err
+	}
+	for _, rel := range written {
+		if err := r.add(RepoGitbook, KindReport, filepath.Join(r.repoPath, filepath.FromSlash(rel))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lastDays: 날짜순 기록의 마지막 n개
+func lastDays(data []common.FocusData, n int) []common.FocusData {
+	if len(data) > n {
+		return data[len(data)-n:]
+	}
+	return data
+}
+
+// firstErr: 첫 번째 nil이 아닌 에러
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Push: manifest 산출물 확인 후 gitbook repo checkout, push, main repo push
+// - regenerate: gitbook rebase 충돌을 덮어쓴 뒤 전체 기록 산출물을 다시 만드는 훅 (nil이면 생략, HistoryRegenerator)
+func Push(m *Manifest, regenerate Regenerator) error {
+	if err := m.Verify(); err != nil {
+		return err
+	}
+
+	// 8. gitbook repo main 브랜치로 checkout (중단된 rebase가 남아 있으면 먼저 정리)
+	if err := abortRebase(m.GitbookRepo); err != nil {
+		return fmt.Errorf("[gitbook checkout 단계] %w", err)
+	}
+	if err := GitRun("-C", m.GitbookRepo, "checkout", "main"); err != nil {
+		return fmt.Errorf("[gitbook checkout 단계] %w", err)
+	}
+
+	// 9. gitbook repo에 그래프/리포트 push
+	if err := PushGitbookAssets(m.GitbookRepo, m.Paths(RepoGitbook), m.CommitMsg, regenerate); err != nil {
+		return err
+	}
+
+	// 10. main repo에 데이터/이미지 push (서브모듈 포인터 충돌은 방금 push한 gitbook HEAD로 맞춤)
+	submodules := map[string]string{}
+	for _, p := range submodulePaths(m.GitbookRepo) {
+		head, err := GitOutput("-C", m.GitbookRepo, "rev-parse", "HEAD")
+		if err != nil {
+			return fmt.Errorf("[main push 단계] %w", err)
+		}
+		submodules[p] = head
+	}
+	if err := PushMainAssets(mainStagePaths(m), regenerablePaths(m, RepoMain), submodules, m.CommitMsg); err != nil {
 		return err
 	}
 	return nil
 }
+
+// HistoryRegenerator: 게시 중 gitbook 충돌을 덮어쓴 뒤 달력/요일 히트맵/HTML 대시보드/리포트 목차·피드를 합쳐진 기록으로 다시 만드는 훅
+// - 다른 실행이 먼저 게시한 날짜의 raw 데이터는 원격 main에서 읽음 (mergedHistory)
+// - 다시 만든 파일의 체크섬은 m에도 반영
+func HistoryRegenerator(m *Manifest, opts ExtractOptions) Regenerator {
+	return func() ([]string, error) {
+		if m.AssetsDir == "" {
+			log.Println("[HistoryRegenerator] 이전 형식 manifest (그래프 디렉토리 없음), 재생성 건너뜀")
+			return nil, nil
+		}
+		history, err := mergedHistory(filepath.Join("dailydata", "raw"), "main")
+		if err != nil {
+			return nil, err
+		}
+		date, err := time.Parse("2006-01-02", m.Date)
+		if err != nil {
+			return nil, fmt.Errorf("manifest 날짜 파싱 실패: %w", err)
+		}
+		if opts.Render.Now.IsZero() {
+			opts.Render.Now = m.GeneratedAt
+		}
+		run := &extractRun{
+			m:         m,
+			opts:      opts,
+			render:    opts.Render,
+			ext:       opts.Render.Format.Ext(),
+			repoPath:  m.GitbookRepo,
+			assetsDir: m.AssetsDir,
+			date:      m.Date,
+			now:       m.GeneratedAt,
+		}
+		if err := firstErr(run.saveCalendar(history, date.Year()), run.saveHistoryArtifacts(history)); err != nil {
+			return nil, err
+		}
+		return m.Paths(RepoGitbook), nil
+	}
+}
+
+// mergedHistory: rawDir 기록에 원격 branch에만 있는 날짜의 raw 데이터를 더한 전체 기록 (날짜순, 같은 날짜는 로컬 우선)
+// - 작업 트리는 건드리지 않고 git show로 읽음 (main repo는 아직 rebase 전일 수 있음)
+func mergedHistory(rawDir, branch string) ([]common.FocusData, error) {
+	local, err := LoadAllFocusData(rawDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := GitRun("fetch", "origin", branch); err != nil {
+		return nil, err
+	}
+	out, err := GitOutput("-c", "core.quotepath=false", "ls-tree", "--name-only", "origin/"+branch, filepath.ToSlash(rawDir)+"/")
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, d := range local {
+		seen[d.Date] = true
+	}
+	history := local
+	for _, name := range strings.Split(out, "\n") {
+		if !strings.HasSuffix(name, ".json") || seen[strings.TrimSuffix(path.Base(name), ".json")] {
+			continue
+		}
+		b, err := GitOutput("show", "origin/"+branch+":"+name)
+		if err != nil {
+			return nil, err
+		}
+		var d common.FocusData
+		if err := json.Unmarshal([]byte(b), &d); err != nil {
+			log.Printf("[mergedHistory] JSON 파싱 실패: %s (%v)", name, err)
+			continue
+		}
+		history = append(history, analyzer.Resample(d, common.DefaultSlotMinutes))
+	}
+	sort.Slice(history, func(i, j int) bool { return history[i].Date < history[j].Date })
+	return history, nil
+}
@@ -1,12 +1,18 @@
 package exporter
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
 	"github.com/crispy/focus-time-tracker/internal/common"
 )
 
@@ -104,7 +110,7 @@ func TestGenerateGraphFile(t *testing.T) {
 		{Date: "2024-06-01", Categories: map[string]int{"업무": 10, "학습": 20, "취미": 0, "수면": 0, "이동": 0}},
 		{Date: "2024-06-02", Categories: map[string]int{"업무": 20, "학습": 10, "취미": 0, "수면": 0, "이동": 0}},
 	}
-	err := GenerateGraphFile(data, path)
+	err := GenerateGraphFile(data, analyzer.DefaultRenderOptions(), path)
 	if err != nil {
 		t.Fatalf("GenerateGraphFile failed: %v", err)
 	}
@@ -113,6 +119,27 @@ func TestGenerateGraphFile(t *testing.T) {
 	}
 }
 
+func TestGenerateGraphFile_SVG(t *testing.T) {
+	tmpDir := t.TempDir()
+	opts := analyzer.DefaultRenderOptions()
+	opts.Format = analyzer.FormatSVG
+	path := filepath.Join(tmpDir, "graph"+opts.Format.Ext())
+	data := []common.FocusData{
+		{Date: "2024-06-01", Categories: map[string]int{"업무": 10, "학습": 20}},
+		{Date: "2024-06-02", Categories: map[string]int{"업무": 20, "학습": 10}},
+	}
+	if err := GenerateGraphFile(data, opts, path); err != nil {
+		t.Fatalf("GenerateGraphFile failed: %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Graph file not created: %v", err)
+	}
+	if !strings.Contains(string(b), "<svg") {
+		t.Errorf("SVG 출력이 아님: %.40s", string(b))
+	}
+}
+
 func TestGitRun(t *testing.T) {
 	err := GitRun("not-a-real-git-command")
 	if err == nil {
@@ -141,3 +168,675 @@ func TestLoadRecentFocusData(t *testing.T) {
 		t.Errorf("Wrong data order: %+v", all)
 	}
 }
+
+func TestSaveDistributionGraphs(t *testing.T) {
+	tmpDir := t.TempDir()
+	opts := analyzer.DefaultRenderOptions()
+	data := []common.FocusData{
+		{Date: "2024-06-01", Categories: map[string]int{"업무": 10}, MaxScore: map[string]int{"업무": 20}, TimeSlots: map[string]int{"09:00": 3}},
+		{Date: "2024-06-02", Categories: map[string]int{"업무": 15}, MaxScore: map[string]int{"업무": 20}, TimeSlots: map[string]int{"09:00": 5}},
+	}
+	if err := SaveDistributionGraphs(data, opts, tmpDir); err != nil {
+		t.Fatalf("SaveDistributionGraphs failed: %v", err)
+	}
+	for _, name := range []string{"category-boxplot.png", "timeslot-boxplot.png"} {
+		if _, err := os.Stat(filepath.Join(tmpDir, name)); err != nil {
+			t.Errorf("Box plot not created: %s", name)
+		}
+	}
+}
+
+func TestParsePublishMode(t *testing.T) {
+	for in, want := range map[string]PublishMode{"": PublishModePush, "push": PublishModePush, " PR ": PublishModePR} {
+		if got, err := ParsePublishMode(in); err != nil || got != want {
+			t.Errorf("ParsePublishMode(%q) = %s, %v", in, got, err)
+		}
+	}
+	if _, err := ParsePublishMode("merge"); err == nil {
+		t.Errorf("지원하지 않는 게시 방식에 에러가 없음")
+	}
+}
+
+func TestNewPublisher(t *testing.T) {
+	for name, want := range map[string]string{"": PublisherGit, "git": PublisherGit, "Dry-Run": PublisherDryRun, "local": PublisherLocal} {
+		p, err := NewPublisher(name, PublisherOptions{LocalDir: t.TempDir()})
+		if err != nil || p.Name() != want {
+			t.Errorf("NewPublisher(%q) = %v, %v", name, p, err)
+		}
+	}
+	if _, err := NewPublisher("local", PublisherOptions{}); err == nil {
+		t.Errorf("local 디렉토리 없이 에러가 없음")
+	}
+	if _, err := NewPublisher("s3", PublisherOptions{}); err == nil {
+		t.Errorf("알 수 없는 백엔드에 에러가 없음")
+	}
+}
+
+// chdirTemp: 임시 디렉토리를 main repo 삼아 작업 디렉토리를 옮기고 테스트 끝에 복원
+func chdirTemp(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	return dir
+}
+
+// initGitRepo: dir에 커밋 하나가 있는 git 저장소 생성
+func initGitRepo(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		if err := WriteFile(filepath.Join(dir, path), []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "-A"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+}
+
+// writeArtifacts: 파일을 쓰고 manifest에 기록
+func writeArtifacts(t *testing.T, m *Manifest, repo ArtifactRepo, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		full := filepath.Join(m.root(repo), path)
+		if err := WriteFile(full, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Add(repo, KindReport, full); err != nil {
+			t.Fatalf("Add(%s) 실패: %v", path, err)
+		}
+	}
+}
+
+func TestManifest(t *testing.T) {
+	chdirTemp(t)
+	gitbook := t.TempDir()
+	m := NewManifest("2025-05-06", "dailydata/raw/2025-05-06.json", "msg", gitbook, time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC))
+	writeArtifacts(t, m, RepoMain, map[string]string{"dailydata/raw/2025-05-06.json": "{}"})
+	writeArtifacts(t, m, RepoGitbook, map[string]string{".gitbook/assets/graph.svg": "<svg/>", "SUMMARY.md": "# toc"})
+	writeArtifacts(t, m, RepoGitbook, map[string]string{"SUMMARY.md": "# toc\n"})
+
+	if got := m.Paths(RepoGitbook); len(got) != 2 || got[0] != filepath.FromSlash(".gitbook/assets/graph.svg") || got[1] != "SUMMARY.md" {
+		t.Errorf("gitbook Paths = %v", got)
+	}
+	if got := m.Paths(RepoMain); len(got) != 1 {
+		t.Errorf("main Paths = %v", got)
+	}
+	if err := m.Add(RepoGitbook, KindGraph, "outside.svg"); err == nil {
+		t.Errorf("저장소 밖 파일에 에러가 없음")
+	}
+	// ".."으로 시작하는 이름이어도 저장소 안이면 허용
+	dotted := NewManifest(m.Date, m.JSONPath, m.CommitMsg, gitbook, m.GeneratedAt)
+	writeArtifacts(t, dotted, RepoGitbook, map[string]string{"..notes.png": "png", "assets/..draft/graph.svg": "<svg/>"})
+	if got := dotted.Paths(RepoGitbook); len(got) != 2 || got[0] != "..notes.png" {
+		t.Errorf("..으로 시작하는 경로 Paths = %v", got)
+	}
+
+	path := filepath.Join(t.TempDir(), ManifestFile)
+	if err := m.Save(path); err != nil {
+		t.Fatalf("Save 실패: %v", err)
+	}
+	loaded, err := LoadManifest(path)
+	if err != nil {
+		t.Fatalf("LoadManifest 실패: %v", err)
+	}
+	if loaded.Date != m.Date || len(loaded.Artifacts) != 3 || loaded.Artifacts[0].SHA256 == "" || loaded.Artifacts[0].Size != 2 {
+		t.Errorf("읽은 manifest 이상: %+v", loaded)
+	}
+	if err := loaded.Verify(); err != nil {
+		t.Errorf("Verify 실패: %v", err)
+	}
+	WriteFile("dailydata/raw/2025-05-06.json", []byte(`{"date":"x"}`))
+	if err := loaded.Verify(); err == nil {
+		t.Errorf("바뀐 산출물에 Verify 에러가 없음")
+	}
+}
+
+func TestLocalPublisher(t *testing.T) {
+	chdirTemp(t)
+	gitbook := t.TempDir()
+	m := NewManifest("2025-05-06", "dailydata/raw/2025-05-06.json", "msg", gitbook, time.Now())
+	writeArtifacts(t, m, RepoGitbook, map[string]string{".gitbook/assets/graph.svg": "<svg/>", "reports/daily/2025-05-06.md": "# 리포트"})
+	writeArtifacts(t, m, RepoMain, map[string]string{"dailydata/raw/2025-05-06.json": "{}"})
+	WriteFile(filepath.Join(gitbook, "README.md"), []byte("산출물 아님"))
+	WriteFile("extract_out.txt", []byte("산출물 아님"))
+
+	out := t.TempDir()
+	p := &LocalPublisher{Dir: out}
+	result, err := p.Publish(context.Background(), m)
+	if err != nil {
+		t.Fatalf("Publish 실패: %v", err)
+	}
+	if len(result.Files) != 3 {
+		t.Errorf("복사 파일 수 = %d: %v", len(result.Files), result.Files)
+	}
+	for _, path := range []string{"gitbook/.gitbook/assets/graph.svg", "gitbook/reports/daily/2025-05-06.md", "main/dailydata/raw/2025-05-06.json"} {
+		if _, err := os.Stat(filepath.Join(out, path)); err != nil {
+			t.Errorf("%s 복사 안 됨", path)
+		}
+	}
+	for _, path := range []string{"gitbook/README.md", "main/extract_out.txt"} {
+		if _, err := os.Stat(filepath.Join(out, path)); err == nil {
+			t.Errorf("manifest에 없는 파일이 복사됨: %s", path)
+		}
+	}
+
+	// 다시 게시하면 바뀐 파일만 복사
+	writeArtifacts(t, m, RepoGitbook, map[string]string{".gitbook/assets/graph.svg": "<svg></svg>"})
+	result, err = p.Publish(context.Background(), m)
+	if err != nil || len(result.Files) != 1 {
+		t.Errorf("재게시 결과 = %v, %v", result.Files, err)
+	}
+}
+
+func TestDryRunPublisher(t *testing.T) {
+	main := chdirTemp(t)
+	initGitRepo(t, main, map[string]string{"dailydata/raw/2025-05-05.json": "{}\n"})
+	gitbook := t.TempDir()
+	initGitRepo(t, gitbook, map[string]string{"SUMMARY.md": "# Table of contents\n", "README.md": "소개\n"})
+
+	m := NewManifest("2025-05-06", "dailydata/raw/2025-05-06.json", "자동 집중도 데이터: 2025-05-06", gitbook, time.Now())
+	writeArtifacts(t, m, RepoGitbook, map[string]string{
+		"SUMMARY.md":                "# Table of contents\n\n* [리포트](reports/README.md)\n",
+		".gitbook/assets/graph.png": "\x89PNG\x00",
+		".gitbook/assets/graph.svg": "# Table of contents\n",
+	})
+	writeArtifacts(t, m, RepoMain, map[string]string{"dailydata/raw/2025-05-06.json": "{\n}\n"})
+	WriteFile(filepath.Join(gitbook, "README.md"), []byte("산출물 아님\n"))
+	WriteFile("extract_out.txt", []byte("산출물 아님\n"))
+	// 커밋된 내용과 같은 산출물은 바뀔 파일이 아님
+	exec.Command("git", "-C", gitbook, "add", ".gitbook/assets/graph.svg").Run()
+	exec.Command("git", "-C", gitbook, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "svg").Run()
+
+	var buf strings.Builder
+	result, err := (&DryRunPublisher{Out: &buf}).Publish(context.Background(), m)
+	if err != nil {
+		t.Fatalf("Publish 실패: %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"커밋 메시지: 자동 집중도 데이터: 2025-05-06 (산출물 4개)",
+		"[gitbook] 2개 파일 변경 예정",
+		"A .gitbook/assets/graph.png (binary)",
+		"M SUMMARY.md (+2 -0)",
+		"[main] 1개 파일 변경 예정",
+		"A dailydata/raw/2025-05-06.json (+2 -0)",
+		"1 files changed, 2 insertions(+), 0 deletions(-)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("출력에 %q 없음:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "README.md") || strings.Contains(got, "extract_out.txt") || strings.Contains(got, "graph.svg") || len(result.Files) != 3 {
+		t.Errorf("manifest 밖 파일 또는 바뀌지 않은 파일 포함 (%v):\n%s", result.Files, got)
+	}
+	// 저장소는 그대로 (스테이징 안 됨)
+	if out, _ := exec.Command("git", "-C", gitbook, "diff", "--cached", "--name-only").Output(); len(out) != 0 {
+		t.Errorf("dry-run이 파일을 스테이징함: %s", out)
+	}
+}
+
+// gitT: dir 저장소에서 git 실행 (실패 시 테스트 중단), 표준 출력 반환
+func gitT(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// newRemote: main 브랜치에 초기 커밋이 있는 bare 저장소와 그 clone 두 개 (우리, 다른 실행)
+func newRemote(t *testing.T, files map[string]string) (bare, ours, theirs string) {
+	t.Helper()
+	bare = filepath.Join(t.TempDir(), "remote.git")
+	seed := t.TempDir()
+	gitT(t, seed, "init", "-q", "-b", "main")
+	for path, content := range files {
+		WriteFile(filepath.Join(seed, path), []byte(content))
+	}
+	gitT(t, seed, "add", "-A")
+	gitT(t, seed, "-c", "user.name=seed", "-c", "user.email=seed@example.com", "commit", "-q", "-m", "init")
+	gitT(t, seed, "clone", "-q", "--bare", seed, bare)
+	clone := func() string {
+		dir := t.TempDir()
+		gitT(t, dir, "clone", "-q", bare, ".")
+		gitT(t, dir, "config", "user.name", "test")
+		gitT(t, dir, "config", "user.email", "test@example.com")
+		return dir
+	}
+	return bare, clone(), clone()
+}
+
+// commitRemote: dir clone에서 files를 커밋해 원격 main에 push (다른 실행의 게시 재현)
+func commitRemote(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	gitT(t, dir, "pull", "-q", "--rebase", "origin", "main")
+	for path, content := range files {
+		WriteFile(filepath.Join(dir, path), []byte(content))
+	}
+	gitT(t, dir, "add", "-A")
+	gitT(t, dir, "commit", "-q", "-m", "other run")
+	gitT(t, dir, "push", "-q", "origin", "HEAD:main")
+}
+
+func TestCommitAndPush_Idempotent(t *testing.T) {
+	bare, ours, _ := newRemote(t, map[string]string{"README.md": "readme\n"})
+	WriteFile(filepath.Join(ours, "dailydata/raw/2025-05-06.json"), []byte("{}\n"))
+	WriteFile(filepath.Join(ours, "extract_out.txt"), []byte("stray\n"))
+	paths := []string{filepath.FromSlash("dailydata/raw/2025-05-06.json")}
+
+	if err := commitAndPush(ours, paths, "자동 집중도 데이터: 2025-05-06", "main", conflictPolicy{}); err != nil {
+		t.Fatalf("첫 push 실패: %v", err)
+	}
+	head := gitT(t, bare, "rev-parse", "main")
+	if files := gitT(t, bare, "ls-tree", "-r", "--name-only", "main"); strings.Contains(files, "extract_out.txt") || !strings.Contains(files, "2025-05-06.json") {
+		t.Errorf("원격 파일 목록 이상:\n%s", files)
+	}
+
+	// 바뀐 것이 없으면 커밋/push 없이 성공
+	if err := commitAndPush(ours, paths, "자동 집중도 데이터: 2025-05-06", "main", conflictPolicy{}); err != nil {
+		t.Fatalf("두 번째 push 실패: %v", err)
+	}
+	if got := gitT(t, bare, "rev-parse", "main"); got != head {
+		t.Errorf("변경 없는 재실행이 커밋을 만듦: %s → %s", head, got)
+	}
+}
+
+func TestCommitAndPush_RegeneratesConflictingImages(t *testing.T) {
+	bare, ours, theirs := newRemote(t, map[string]string{".gitbook/assets/graph.png": "old\x00png", "SUMMARY.md": "# toc\n"})
+	commitRemote(t, theirs, map[string]string{".gitbook/assets/graph.png": "their\x00png", "reports/daily/2025-05-05.md": "# 5/5\n"})
+
+	WriteFile(filepath.Join(ours, ".gitbook/assets/graph.png"), []byte("our\x00png"))
+	WriteFile(filepath.Join(ours, "reports/daily/2025-05-06.md"), []byte("# 5/6\n"))
+	paths := []string{filepath.FromSlash(".gitbook/assets/graph.png"), filepath.FromSlash("reports/daily/2025-05-06.md")}
+	if err := PushGitbookAssets(ours, paths, "자동 집중도 데이터: 2025-05-06", nil); err != nil {
+		t.Fatalf("push 실패: %v", err)
+	}
+	if got := gitT(t, bare, "show", "main:.gitbook/assets/graph.png"); got != "our\x00png" {
+		t.Errorf("충돌 이미지가 이번 실행 결과가 아님: %q", got)
+	}
+	files := gitT(t, bare, "ls-tree", "-r", "--name-only", "main")
+	if !strings.Contains(files, "2025-05-05.md") || !strings.Contains(files, "2025-05-06.md") {
+		t.Errorf("양쪽 리포트가 모두 있어야 함:\n%s", files)
+	}
+	if inProgress, _ := rebaseInProgress(ours); inProgress {
+		t.Errorf("rebase가 끝나지 않음")
+	}
+}
+
+func TestCommitAndPush_RegeneratesHistoryArtifacts(t *testing.T) {
+	bare, ours, theirs := newRemote(t, map[string]string{"SUMMARY.md": "# toc\n", "reports/feed.xml": "<feed/>\n"})
+	commitRemote(t, theirs, map[string]string{"SUMMARY.md": "# toc\n- 2025-05-05\n", "reports/feed.xml": "<feed>05</feed>\n", "reports/daily/2025-05-05.md": "# 5/5\n"})
+
+	WriteFile(filepath.Join(ours, "SUMMARY.md"), []byte("# toc\n- 2025-05-06\n"))
+	WriteFile(filepath.Join(ours, "reports/feed.xml"), []byte("<feed>06</feed>\n"))
+	WriteFile(filepath.Join(ours, "reports/daily/2025-05-06.md"), []byte("# 5/6\n"))
+	paths := []string{"SUMMARY.md", filepath.FromSlash("reports/feed.xml"), filepath.FromSlash("reports/daily/2025-05-06.md")}
+
+	// 재생성 훅: rebase 후 작업 트리의 리포트 전체로 목차를 다시 씀
+	calls := 0
+	regenerate := func() ([]string, error) {
+		calls++
+		reports, _ := filepath.Glob(filepath.Join(ours, "reports", "daily", "*.md"))
+		toc := "# toc\n"
+		for _, r := range reports {
+			toc += "- " + strings.TrimSuffix(filepath.Base(r), ".md") + "\n"
+		}
+		return []string{"SUMMARY.md"}, WriteFile(filepath.Join(ours, "SUMMARY.md"), []byte(toc))
+	}
+	if err := PushGitbookAssets(ours, paths, "자동 집중도 데이터: 2025-05-06", regenerate); err != nil {
+		t.Fatalf("목차/피드 충돌로 push 실패: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("재생성 훅 호출 = %d회 (1회 기대)", calls)
+	}
+	if got := gitT(t, bare, "show", "main:SUMMARY.md"); got != "# toc\n- 2025-05-05\n- 2025-05-06" {
+		t.Errorf("목차가 합쳐진 기록으로 다시 만들어지지 않음: %q", got)
+	}
+	if got := gitT(t, bare, "show", "main:reports/feed.xml"); got != "<feed>06</feed>" {
+		t.Errorf("충돌 피드가 이번 실행 결과가 아님: %q", got)
+	}
+	if inProgress, _ := rebaseInProgress(ours); inProgress {
+		t.Errorf("rebase가 끝나지 않음")
+	}
+}
+
+func TestCommitAndPush_ResolvesSubmodulePointer(t *testing.T) {
+	seedSHA := strings.Repeat("1", 40)
+	bare, ours, theirs := newRemote(t, map[string]string{"README.md": "readme\n"})
+	gitT(t, theirs, "update-index", "--add", "--cacheinfo", "160000,"+seedSHA+",gitbook")
+	gitT(t, theirs, "commit", "-q", "-m", "add gitbook")
+	gitT(t, theirs, "push", "-q", "origin", "HEAD:main")
+	gitT(t, ours, "pull", "-q", "origin", "main")
+
+	// 다른 실행이 먼저 포인터를 올림
+	gitT(t, theirs, "update-index", "--cacheinfo", "160000,"+strings.Repeat("2", 40)+",gitbook")
+	gitT(t, theirs, "commit", "-q", "-m", "other run bumps gitbook")
+	gitT(t, theirs, "push", "-q", "origin", "HEAD:main")
+
+	// 이번 실행: gitbook 서브모듈에 새 커밋을 만들고 포인터를 올림
+	sub := filepath.Join(ours, "gitbook")
+	WriteFile(filepath.Join(sub, "SUMMARY.md"), []byte("# toc\n"))
+	gitT(t, sub, "init", "-q")
+	gitT(t, sub, "add", "-A")
+	gitT(t, sub, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "gitbook")
+	head := gitT(t, sub, "rev-parse", "HEAD")
+	WriteFile(filepath.Join(ours, "dailydata/raw/2025-05-06.json"), []byte("{}\n"))
+	paths := []string{filepath.FromSlash("dailydata/raw/2025-05-06.json"), "gitbook"}
+
+	policy := conflictPolicy{submodules: map[string]string{"gitbook": head}}
+	if err := commitAndPush(ours, paths, "msg", "main", policy); err != nil {
+		t.Fatalf("양쪽이 포인터를 올린 push 실패: %v", err)
+	}
+	if got := gitT(t, bare, "ls-tree", "main", "gitbook"); !strings.Contains(got, head) {
+		t.Errorf("서브모듈 포인터가 push한 gitbook HEAD가 아님: %s (want %s)", got, head)
+	}
+	if inProgress, _ := rebaseInProgress(ours); inProgress {
+		t.Errorf("rebase가 끝나지 않음")
+	}
+}
+
+func TestPushPRBranch_SubmoduleAndWorkTree(t *testing.T) {
+	seedSHA := strings.Repeat("1", 40)
+	bare, ours, theirs := newRemote(t, map[string]string{"README.md": "readme\n"})
+	gitT(t, theirs, "update-index", "--add", "--cacheinfo", "160000,"+seedSHA+",gitbook")
+	gitT(t, theirs, "commit", "-q", "-m", "add gitbook")
+	gitT(t, theirs, "push", "-q", "origin", "HEAD:main")
+	gitT(t, ours, "pull", "-q", "origin", "main")
+
+	// gitbook은 gitbook PR 브랜치 커밋으로 움직인 상태
+	sub := filepath.Join(ours, "gitbook")
+	WriteFile(filepath.Join(sub, "SUMMARY.md"), []byte("# toc\n"))
+	gitT(t, sub, "init", "-q")
+	gitT(t, sub, "add", "-A")
+	gitT(t, sub, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "gitbook PR")
+	wd, _ := os.Getwd()
+	if err := os.Chdir(ours); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	jsonPath := filepath.FromSlash("dailydata/raw/2025-05-06.json")
+	WriteFile(jsonPath, []byte("{\"date\":\"2025-05-06\"}\n"))
+	m := NewManifest("2025-05-06", jsonPath, "msg", "gitbook", time.Now())
+	if err := m.Add(RepoMain, KindData, jsonPath); err != nil {
+		t.Fatal(err)
+	}
+	if got := submodulePaths(m.GitbookRepo); len(got) != 1 {
+		t.Fatalf("테스트 준비 실패: 서브모듈 인식 안 됨 %v", got)
+	}
+	paths := prMainPaths(m)
+	branch := "focus/2025-05-06"
+	gitT(t, ours, "checkout", "-q", "-B", branch)
+	sha, err := pushPRBranch("", paths, conflictPolicy{}, branch, "msg", "main")
+	if cerr := returnToBase("", "main", branch, paths); err == nil {
+		err = cerr
+	}
+	if err != nil || sha == "" {
+		t.Fatalf("PR 브랜치 push 실패: %q, %v", sha, err)
+	}
+
+	// PR 브랜치: 데이터는 있고 서브모듈 포인터는 main 그대로
+	if got := gitT(t, bare, "ls-tree", branch, "gitbook"); !strings.Contains(got, seedSHA) {
+		t.Errorf("main PR이 서브모듈 포인터를 바꿈: %s", got)
+	}
+	if got := gitT(t, bare, "show", branch+":dailydata/raw/2025-05-06.json"); !strings.Contains(got, "2025-05-06") {
+		t.Errorf("PR 브랜치에 데이터가 없음: %q", got)
+	}
+	// 로컬: base로 돌아왔고 이번 실행 산출물은 작업 트리에 남음
+	if got := gitT(t, ours, "rev-parse", "--abbrev-ref", "HEAD"); got != "main" {
+		t.Errorf("base 브랜치로 돌아오지 않음: %s", got)
+	}
+	if b, err := os.ReadFile(jsonPath); err != nil || !strings.Contains(string(b), "2025-05-06") {
+		t.Errorf("작업 트리에서 산출물이 사라짐: %q, %v", b, err)
+	}
+	if got := gitT(t, ours, "ls-tree", "main", "dailydata/raw/2025-05-06.json"); got != "" {
+		t.Errorf("로컬 main에 커밋됨: %s", got)
+	}
+}
+
+func TestRegenerablePaths(t *testing.T) {
+	dir := chdirTemp(t)
+	m := NewManifest("2025-05-06", "dailydata/raw/2025-05-06.json", "msg", filepath.Join(dir, "gitbook"), time.Now())
+	for path, kind := range map[string]ArtifactKind{"dailydata/raw/2025-05-06.json": KindData, "dailydata/2025-05-06.png": KindGraph} {
+		WriteFile(path, []byte("x"))
+		if err := m.Add(RepoMain, kind, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := regenerablePaths(m, RepoMain); !reflect.DeepEqual(got, []string{filepath.FromSlash("dailydata/2025-05-06.png")}) {
+		t.Errorf("regenerablePaths = %v (raw 데이터 제외)", got)
+	}
+}
+
+func TestMergedHistory(t *testing.T) {
+	_, ours, theirs := newRemote(t, map[string]string{"dailydata/raw/2025-05-04.json": `{"date":"2025-05-04"}`})
+	commitRemote(t, theirs, map[string]string{"dailydata/raw/2025-05-05.json": `{"date":"2025-05-05"}`})
+	WriteFile(filepath.Join(ours, "dailydata/raw/2025-05-06.json"), []byte(`{"date":"2025-05-06"}`))
+	wd, _ := os.Getwd()
+	if err := os.Chdir(ours); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	history, err := mergedHistory(filepath.Join("dailydata", "raw"), "main")
+	if err != nil {
+		t.Fatalf("mergedHistory 실패: %v", err)
+	}
+	var dates []string
+	for _, d := range history {
+		dates = append(dates, d.Date)
+	}
+	if want := []string{"2025-05-04", "2025-05-05", "2025-05-06"}; !reflect.DeepEqual(dates, want) {
+		t.Errorf("날짜 = %v (want %v)", dates, want)
+	}
+}
+
+func TestCommitAndPush_AbortsOnDataConflict(t *testing.T) {
+	bare, ours, theirs := newRemote(t, map[string]string{"dailydata/raw/2025-05-06.json": "{}\n"})
+	commitRemote(t, theirs, map[string]string{"dailydata/raw/2025-05-06.json": "{\"date\":\"their\"}\n"})
+	remoteHead := gitT(t, bare, "rev-parse", "main")
+
+	WriteFile(filepath.Join(ours, "dailydata/raw/2025-05-06.json"), []byte("{\"date\":\"our\"}\n"))
+	err := commitAndPush(ours, []string{filepath.FromSlash("dailydata/raw/2025-05-06.json")}, "msg", "main", conflictPolicy{})
+	if err == nil || !strings.Contains(err.Error(), "rebase 충돌") {
+		t.Fatalf("데이터 충돌에 에러가 없음: %v", err)
+	}
+	if inProgress, _ := rebaseInProgress(ours); inProgress {
+		t.Errorf("실패한 rebase가 남아 있음")
+	}
+	if got := gitT(t, ours, "show", "HEAD:dailydata/raw/2025-05-06.json"); got != "{\"date\":\"our\"}" {
+		t.Errorf("로컬 커밋이 보존되지 않음: %q", got)
+	}
+	if got := gitT(t, bare, "rev-parse", "main"); got != remoteHead {
+		t.Errorf("충돌 상태로 원격이 바뀜")
+	}
+
+	// 남아 있던 rebase도 다음 실행이 정리
+	gitT(t, ours, "fetch", "-q", "origin")
+	exec.Command("git", "-C", ours, "rebase", "origin/main").Run()
+	if inProgress, _ := rebaseInProgress(ours); !inProgress {
+		t.Fatalf("테스트 준비 실패: rebase 중이 아님")
+	}
+	commitAndPush(ours, nil, "msg", "main", conflictPolicy{})
+	if inProgress, _ := rebaseInProgress(ours); inProgress {
+		t.Errorf("이전 실행의 rebase가 정리되지 않음")
+	}
+}
+
+func TestCommitAndPush_RetriesNonFastForward(t *testing.T) {
+	bare, ours, theirs := newRemote(t, map[string]string{"README.md": "readme\n"})
+	oldBackoff := pushBackoff
+	pushBackoff = time.Millisecond
+	calls := 0
+	beforePush = func(dir string) {
+		calls++
+		if calls == 1 {
+			// fetch 이후 push 직전에 다른 실행이 먼저 push
+			commitRemote(t, theirs, map[string]string{"dailydata/raw/2025-05-05.json": "{}\n"})
+		}
+	}
+	t.Cleanup(func() { pushBackoff, beforePush = oldBackoff, nil })
+
+	WriteFile(filepath.Join(ours, "dailydata/raw/2025-05-06.json"), []byte("{}\n"))
+	if err := commitAndPush(ours, []string{filepath.FromSlash("dailydata/raw/2025-05-06.json")}, "msg", "main", conflictPolicy{}); err != nil {
+		t.Fatalf("재시도 후 push 실패: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("push 시도 횟수 = %d (2회 기대)", calls)
+	}
+	files := gitT(t, bare, "ls-tree", "-r", "--name-only", "main")
+	if !strings.Contains(files, "2025-05-05.json") || !strings.Contains(files, "2025-05-06.json") {
+		t.Errorf("양쪽 데이터가 모두 있어야 함:\n%s", files)
+	}
+}
+
+func TestParseRetention(t *testing.T) {
+	if p, err := ParseRetention(""); err != nil || p != DefaultRetention {
+		t.Errorf("기본 정책 = %v, %v", p, err)
+	}
+	if p, err := ParseRetention(" 7d, 4W "); err != nil || p != (RetentionPolicy{DailyDays: 7, WeeklyWeeks: 4}) || p.String() != "7d,4w,0m" {
+		t.Errorf("ParseRetention = %v, %v", p, err)
+	}
+	for _, bad := range []string{"30", "30x", "d", "-1d", "0d,4w"} {
+		if _, err := ParseRetention(bad); err == nil {
+			t.Errorf("ParseRetention(%q)에 에러가 없음", bad)
+		}
+	}
+}
+
+func TestPrune(t *testing.T) {
+	dir := chdirTemp(t)
+	files := map[string]string{
+		"dailydata/raw/2025-02-01.json":   "{}",
+		"dailydata/images/graph.png":      "날짜 아님",
+		"dailydata/images/2025-02-01.svg": "<svg/>",
+	}
+	for d := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC); d.Month() < 6; d = d.AddDate(0, 0, 1) {
+		files["dailydata/images/"+d.Format("2006-01-02")+".png"] = "png"
+	}
+	initGitRepo(t, dir, files)
+	WriteFile("dailydata/timeslot-images/2025-02-02.png", []byte("추적 안 됨"))
+	policy := RetentionPolicy{DailyDays: 3, WeeklyWeeks: 2, MonthlyMonths: 2}
+	today := time.Date(2025, 5, 31, 23, 0, 0, 0, time.FixedZone("KST", 9*3600))
+
+	planned, err := Prune(PruneDirs, policy, today, true)
+	if err != nil {
+		t.Fatalf("Prune(dry-run) 실패: %v", err)
+	}
+	if _, err := os.Stat("dailydata/images/2025-02-01.png"); err != nil {
+		t.Errorf("dry-run이 파일을 삭제함")
+	}
+	removed, err := Prune(PruneDirs, policy, today, false)
+	if err != nil {
+		t.Fatalf("Prune 실패: %v", err)
+	}
+	if strings.Join(planned, ",") != strings.Join(removed, ",") {
+		t.Errorf("dry-run 목록과 실제 삭제 목록이 다름")
+	}
+
+	// 일간: 5/29~31, 주간(5/15~28): 주마다 마지막 날, 월간(3/15~5/14): 달마다 마지막 날
+	left, _ := filepath.Glob("dailydata/images/*")
+	var got []string
+	for _, f := range left {
+		got = append(got, filepath.Base(f))
+	}
+	want := "2025-03-31.png,2025-04-30.png,2025-05-18.png,2025-05-25.png,2025-05-29.png,2025-05-30.png,2025-05-31.png,graph.png"
+	if strings.Join(got, ",") != want {
+		t.Errorf("남은 파일 = %v", got)
+	}
+	if len(removed) != 120-7+2 || removed[0] != "dailydata/images/2025-02-01.png" {
+		t.Errorf("삭제 목록 이상: %d개 %v", len(removed), removed[:2])
+	}
+	if _, err := os.Stat("dailydata/raw/2025-02-01.json"); err != nil {
+		t.Errorf("raw JSON이 삭제됨")
+	}
+
+	// 다시 실행해도 더 지울 것이 없고, 커밋된 파일의 삭제만 스테이징 대상
+	if again, err := Prune(PruneDirs, policy, today, false); err != nil || len(again) != 0 {
+		t.Errorf("재실행 결과 = %v, %v", again, err)
+	}
+	m := NewManifest("2025-05-31", "", "msg", "", today)
+	m.Pruned = removed
+	staged := mainStagePaths(m)
+	if len(staged) != len(removed)-1 {
+		t.Errorf("스테이징 경로 수 = %d", len(staged))
+	}
+	for _, p := range staged {
+		if strings.Contains(p, "timeslot-images") {
+			t.Errorf("추적하지 않는 파일이 스테이징 대상: %s", p)
+		}
+	}
+	if _, err := Prune([]string{"dailydata/raw"}, policy, today, true); err == nil {
+		t.Errorf("raw 디렉토리 정리에 에러가 없음")
+	}
+
+	// 4/30(수)은 주간 구간(4/28~5/4)에서 주 마지막이 아니지만 4월의 마지막 날이라 남음
+	for d := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC); !d.After(time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)); d = d.AddDate(0, 0, 1) {
+		WriteFile("april/"+d.Format("2006-01-02")+".png", []byte("png"))
+	}
+	if _, err := Prune([]string{"april"}, RetentionPolicy{DailyDays: 1, WeeklyWeeks: 1, MonthlyMonths: 2}, time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), false); err != nil {
+		t.Fatalf("Prune(april) 실패: %v", err)
+	}
+	left, _ = filepath.Glob("april/*")
+	got = nil
+	for _, f := range left {
+		got = append(got, filepath.Base(f))
+	}
+	if want := "2025-04-30.png,2025-05-04.png,2025-05-05.png"; strings.Join(got, ",") != want {
+		t.Errorf("주간 구간에 걸친 달의 남은 파일 = %v", got)
+	}
+}
+
+func TestExtractRun_MonthlyGraphs(t *testing.T) {
+	tmpDir := t.TempDir()
+	opts := analyzer.DefaultRenderOptions()
+	run := &extractRun{
+		m:         NewManifest("2024-06-02", "dailydata/raw/2024-06-02.json", "msg", tmpDir, time.Now()),
+		render:    opts,
+		ext:       opts.Format.Ext(),
+		repoPath:  tmpDir,
+		assetsDir: "assets",
+		date:      "2024-06-02",
+	}
+	data := []common.FocusData{
+		{Date: "2024-06-01", Categories: map[string]int{"업무": 10}, MaxScore: map[string]int{"업무": 20}, TimeSlots: map[string]int{"09:00": 3}},
+		{Date: "2024-06-02", Categories: map[string]int{"업무": 15}, MaxScore: map[string]int{"업무": 20}, TimeSlots: map[string]int{"09:00": 5}},
+	}
+	if err := run.saveMonthlyGraphs(lastDays(data, 30)); err != nil {
+		t.Fatalf("saveMonthlyGraphs failed: %v", err)
+	}
+	want := []string{ // Paths는 경로순
+		"assets/category-boxplot.png", "assets/category-share-normalized.png", "assets/category-share.png",
+		"assets/dashboard.png", "assets/timeslot-boxplot.png",
+	}
+	if got := run.m.Paths(RepoGitbook); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("manifest paths = %v, want %v", got, want)
+	}
+	if err := run.saveMonthlyGraphs(nil); err != nil || len(run.m.Artifacts) != len(want) {
+		t.Errorf("빈 기록이면 아무것도 만들지 않아야 함: %v, %d", err, len(run.m.Artifacts))
+	}
+}
+
+func TestLastDays(t *testing.T) {
+	data := []common.FocusData{{Date: "2024-06-01"}, {Date: "2024-06-02"}, {Date: "2024-06-03"}}
+	if got := lastDays(data, 2); len(got) != 2 || got[0].Date != "2024-06-02" {
+		t.Errorf("lastDays(2) = %+v", got)
+	}
+	if got := lastDays(data, 7); len(got) != 3 {
+		t.Errorf("lastDays(7) = %d개, want 3", len(got))
+	}
+}
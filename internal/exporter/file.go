@@ -10,6 +10,7 @@ import (
 
 	"github.com/crispy/focus-time-tracker/internal/analyzer"
 	"github.com/crispy/focus-time-tracker/internal/common"
+	"gonum.org/v1/plot/vg"
 )
 
 // SaveJSON: FocusData를 JSON 파일로 저장
@@ -80,17 +81,24 @@ func ReadFocusDataFile(path string) (common.FocusData, error) {
 
 // GenerateGraphFile: 동일 이미지를 여러 경로에 저장
 // - data: 그래프에 쓸 FocusData 배열
+// - opts: 출력 포맷/크기/DPI (경로 확장자는 호출자가 opts.Format.Ext()에 맞춤)
 // - paths: 저장할 경로들
-func GenerateGraphFile(data []common.FocusData, paths ...string) error {
-	b, err := analyzer.PlotFocusTrendsAndRegression(data)
+func GenerateGraphFile(data []common.FocusData, opts analyzer.RenderOptions, paths ...string) error {
+	return renderTo(func() ([]byte, error) {
+		return analyzer.PlotFocusTrendsAndRegression(data, opts)
+	}, paths...)
+}
+
+// renderTo: 그래프를 한 번 렌더링해 같은 바이트를 여러 경로에 저장
+// - plot: 렌더링 함수 (에러면 아무 파일도 쓰지 않음)
+// - paths: 저장할 경로들 (디렉토리 자동 생성)
+func renderTo(plot func() ([]byte, error), paths ...string) error {
+	img, err := plot()
 	if err != nil {
 		return err
 	}
 	for _, path := range paths {
-		if err := EnsureGraphFile(path); err != nil {
-			return err
-		}
-		if err := WriteFile(path, b); err != nil {
+		if err := WriteFile(path, img); err != nil {
 			return err
 		}
 	}
@@ -100,6 +108,7 @@ func GenerateGraphFile(data []common.FocusData, paths ...string) error {
 // LoadRecentFocusData: 최근 N일치 FocusData를 로드
 // - rawDir: JSON 파일 디렉토리
 // - days: 최근 N일
+// - 슬롯 길이가 다른 날이 섞여 있어도 그래프/리포트가 같은 시간대 키를 쓰도록 DefaultSlotMinutes로 맞춤 (원본 JSON은 그대로)
 // 반환: FocusData 배열, 에러
 func LoadRecentFocusData(rawDir string, days int) ([]common.FocusData, error) {
 	files, err := filepath.Glob(filepath.Join(rawDir, "*.json"))
@@ -121,24 +130,129 @@ func LoadRecentFocusData(rawDir string, days int) ([]common.FocusData, error) {
 			log.Printf("[LoadRecentFocusData] JSON 파싱 실패: %s (%v)", f, err)
 			continue
 		}
-		allData = append(allData, d)
+		allData = append(allData, analyzer.Resample(d, common.DefaultSlotMinutes))
 	}
 	return allData, nil
 }
 
-// SaveTimeSlotGraphs: 일자별 시간대별 몰입 그래프를 PNG로 저장 + 7일 평균 그래프도 저장
-// - data: FocusData 배열
-// - paths: 저장할 경로들
-func SaveTimeSlotGraphs(data []common.FocusData, paths ...string) error {
-	// 7일 평균 시간대별 몰입 그래프 저장
-	avgImg, err := analyzer.PlotTimeSlotAverageFocusAggregatePNG(data)
+// LoadAllFocusData: rawDir의 모든 FocusData를 로드 (파일명=날짜 순)
+// - rawDir: JSON 파일 디렉토리
+// 반환: FocusData 배열, 에러
+func LoadAllFocusData(rawDir string) ([]common.FocusData, error) {
+	files, err := filepath.Glob(filepath.Join(rawDir, "*.json"))
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("파일 glob 실패: %w", err)
 	}
-	for _, path := range paths {
-		if err := WriteFile(path, avgImg); err != nil {
+	return LoadRecentFocusData(rawDir, len(files))
+}
+
+// SaveCalendarHeatmap: 연간 달력 히트맵을 여러 경로에 저장
+// - data: FocusData 배열 (cal.Year 외 연도는 무시됨)
+// - cal: 달력 옵션
+// - opts: 출력 포맷/크기/DPI
+// - paths: 저장할 경로들
+func SaveCalendarHeatmap(data []common.FocusData, cal analyzer.CalendarOptions, opts analyzer.RenderOptions, paths ...string) error {
+	return renderTo(func() ([]byte, error) {
+		return analyzer.PlotCalendarHeatmap(data, cal, opts)
+	}, paths...)
+}
+
+// SaveCategoryShareGraphs: 카테고리 누적 영역 그래프(슬롯 수, 100% 정규화)를 dir에 저장
+// - data: FocusData 배열
+// - opts: 출력 포맷/크기/DPI
+// - dir: 저장 디렉토리 (category-share, category-share-normalized 파일 생성)
+func SaveCategoryShareGraphs(data []common.FocusData, opts analyzer.RenderOptions, dir string) error {
+	ext := opts.Format.Ext()
+	graphs := []struct {
+		name       string
+		normalized bool
+	}{
+		{"category-share", false},
+		{"category-share-normalized", true},
+	}
+	for _, g := range graphs {
+		err := renderTo(func() ([]byte, error) {
+			return analyzer.PlotCategoryShare(data, g.normalized, opts)
+		}, filepath.Join(dir, g.name+ext))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveDistributionGraphs: 분포 박스 플롯(카테고리별 효율, 시간대별 슬롯 점수)을 dir에 저장
+// - data: FocusData 배열 (분포를 볼 기간)
+// - opts: 출력 포맷/크기/DPI
+// - dir: 저장 디렉토리 (category-boxplot, timeslot-boxplot 파일 생성)
+func SaveDistributionGraphs(data []common.FocusData, opts analyzer.RenderOptions, dir string) error {
+	ext := opts.Format.Ext()
+	graphs := []struct {
+		name string
+		plot func([]common.FocusData, analyzer.RenderOptions) ([]byte, error)
+	}{
+		{"category-boxplot", analyzer.PlotCategoryBoxPlot},
+		{"timeslot-boxplot", analyzer.PlotTimeSlotBoxPlot},
+	}
+	for _, g := range graphs {
+		err := renderTo(func() ([]byte, error) {
+			return g.plot(data, opts)
+		}, filepath.Join(dir, g.name+ext))
+		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// SaveDashboard: 여러 패널을 타일로 모은 대시보드 이미지를 여러 경로에 저장
+// - data: FocusData 배열 (대시보드 기간)
+// - dash: 패널 종류/배치
+// - opts: 출력 포맷/너비/DPI (높이는 패널 줄 수에 맞춰 계산)
+// - paths: 저장할 경로들
+func SaveDashboard(data []common.FocusData, dash analyzer.DashboardOptions, opts analyzer.RenderOptions, paths ...string) error {
+	if len(dash.Panels) == 0 {
+		dash.Panels = analyzer.DefaultDashboardOptions().Panels
+	}
+	width := opts.Width
+	if width <= 0 {
+		width = analyzer.DefaultRenderOptions().Width
+	}
+	// 패널 한 줄당 너비의 3/8 + 헤더
+	opts.Height = width*3/8*vg.Length(dash.Rows()) + vg.Points(60)
+	return renderTo(func() ([]byte, error) {
+		return analyzer.PlotDashboard(data, dash, opts)
+	}, paths...)
+}
+
+// SaveWeekdayHeatmap: 요일×시간대 평균 몰입 점수 히트맵을 여러 경로에 저장
+// - data: FocusData 배열
+// - hm: 카테고리 필터/해상도
+// - opts: 출력 포맷/크기/DPI
+// - paths: 저장할 경로들
+func SaveWeekdayHeatmap(data []common.FocusData, hm analyzer.WeekdayHeatmapOptions, opts analyzer.RenderOptions, paths ...string) error {
+	return renderTo(func() ([]byte, error) {
+		return analyzer.PlotWeekdayHeatmap(data, hm, opts)
+	}, paths...)
+}
+
+// SaveDailyTimeline: 슬롯 원본으로 24시간 타임라인 그래프를 여러 경로에 저장
+// - days: 하루치 슬롯 원본 배열 (여러 날이면 위→아래로 쌓음)
+// - opts: 출력 포맷/크기/DPI
+// - paths: 저장할 경로들
+func SaveDailyTimeline(days []common.DaySlots, opts analyzer.RenderOptions, paths ...string) error {
+	return renderTo(func() ([]byte, error) {
+		return analyzer.PlotDailyTimeline(days, opts)
+	}, paths...)
+}
+
+// SaveTimeSlotGraphs: 일자별 시간대별 몰입 그래프를 저장 + 7일 평균 그래프도 저장
+// - data: FocusData 배열
+// - opts: 출력 포맷/크기/DPI
+// - paths: 저장할 경로들
+func SaveTimeSlotGraphs(data []common.FocusData, opts analyzer.RenderOptions, paths ...string) error {
+	// 7일 평균 시간대별 몰입 그래프 저장
+	return renderTo(func() ([]byte, error) {
+		return analyzer.PlotTimeSlotAverageFocusAggregate(data, opts)
+	}, paths...)
+}
@@ -3,64 +3,340 @@ package exporter
 import (
 	"fmt"
 	"log"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
 // GitRun: git 명령 실행 및 결과 로그 출력
 // - args: git 명령 인자 (예: ["add", "."])
 // 반환: 에러 (실패 시)
 func GitRun(args ...string) error {
+	_, err := gitRunOutput(args...)
+	return err
+}
+
+// gitRunOutput: GitRun과 같되 표준 출력+에러 출력을 함께 반환 (push 거부 사유 판별용)
+func gitRunOutput(args ...string) (string, error) {
 	log.Printf("[git] 실행: %v", args)
-	cmd := exec.Command("git", args...)
-	out, err := cmd.CombinedOutput()
+	out, err := exec.Command("git", args...).CombinedOutput()
 	if err != nil {
 		log.Printf("[git] 에러: %s", string(out))
-		return fmt.Errorf("git %v: %w", args, err)
+		return string(out), fmt.Errorf("git %v: %w", args, err)
 	}
 	log.Printf("[git] 결과: %s", string(out))
-	return nil
+	return string(out), nil
+}
+
+// GitOutput: git 명령 실행 후 표준 출력 반환 (앞뒤 공백 제거)
+// - args: git 명령 인자 (예: ["rev-parse", "HEAD"])
+func GitOutput(args ...string) (string, error) {
+	out, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return "", fmt.Errorf("git %v: %w", args, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// addArgs: paths를 한 번에 스테이징하는 git add 명령 (dir이 비면 현재 디렉토리 저장소)
+func addArgs(dir string, paths []string) [][]string {
+	return [][]string{gitArgs(dir, append([]string{"add", "--"}, paths...)...)}
+}
+
+// mainStagePaths: main repo에 스테이징할 경로 (manifest 산출물 + 정리한 파일 삭제 + gitbook 서브모듈 포인터)
+func mainStagePaths(m *Manifest) []string {
+	paths := append(m.Paths(RepoMain), trackedPaths(m.Pruned)...)
+	return append(paths, submodulePaths(m.GitbookRepo)...)
 }
 
-// PushGitbookAssets: gitbook repo에 그래프 push
+// trackedPaths: paths 중 main repo가 추적하는 경로 (커밋한 적 없는 파일의 삭제는 스테이징할 수 없음)
+func trackedPaths(paths []string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+	out, err := GitOutput(append([]string{"-c", "core.quotepath=false", "ls-files", "--"}, paths...)...)
+	if err != nil || out == "" {
+		return nil
+	}
+	tracked := []string{}
+	for _, p := range strings.Split(out, "\n") {
+		tracked = append(tracked, filepath.FromSlash(p))
+	}
+	return tracked
+}
+
+// submodulePaths: repoPath가 main repo의 서브모듈이면 [repoPath] (gitbook push 후 포인터 갱신용)
+func submodulePaths(repoPath string) []string {
+	if repoPath == "" || filepath.IsAbs(repoPath) {
+		return nil
+	}
+	out, err := GitOutput("ls-files", "--stage", "--", repoPath)
+	if err != nil || !strings.HasPrefix(out, "160000 ") {
+		return nil
+	}
+	return []string{repoPath}
+}
+
+// gitArgs: dir 저장소에서 실행할 git 인자 (dir이 비면 -C 생략)
+func gitArgs(dir string, args ...string) []string {
+	if dir == "" {
+		return args
+	}
+	return append([]string{"-C", dir}, args...)
+}
+
+// push 재시도 정책 (non-fast-forward로 거부되면 backoff, 2×backoff, 4×backoff… 후 다시 fetch/rebase/push)
+var (
+	pushAttempts = 4
+	pushBackoff  = 2 * time.Second
+)
+
+// maxRebaseSteps: 충돌 해결 후 rebase --continue를 반복하는 최대 횟수
+const maxRebaseSteps = 20
+
+// beforePush: push 직전 훅 (테스트에서 원격 경합 재현용, 평소에는 nil)
+var beforePush func(dir string)
+
+// Regenerator: rebase 충돌을 이번 실행 결과로 덮어쓴 뒤, 합쳐진 기록으로 산출물을 다시 만드는 훅
+// 반환: 다시 만든 파일 (저장소 기준, 다시 스테이징/커밋), 에러
+type Regenerator func() ([]string, error)
+
+// conflictPolicy: rebase 충돌 처리 방식
+// - regenerable: 충돌 시 이번 실행 결과로 덮어쓰는 경로 (manifest의 생성 산출물, 그 밖의 충돌은 rebase 취소)
+// - regenerate: 덮어쓴 뒤 호출할 재생성 훅 (nil이면 덮어쓴 파일 그대로)
+// - submodules: 충돌 시 맞출 서브모듈 경로 → 커밋 (방금 push한 gitbook HEAD, 양쪽이 포인터를 올려도 실패하지 않음)
+type conflictPolicy struct {
+	regenerable []string
+	regenerate  Regenerator
+	submodules  map[string]string
+}
+
+// regenerablePaths: repo 산출물 중 다시 만들 수 있는 경로 (raw 데이터 JSON은 원본이라 제외)
+func regenerablePaths(m *Manifest, repo ArtifactRepo) []string {
+	var paths []string
+	for _, a := range m.Artifacts {
+		if a.Repo == repo && a.Kind != KindData {
+			paths = append(paths, filepath.FromSlash(a.Path))
+		}
+	}
+	return paths
+}
+
+// PushGitbookAssets: gitbook repo에 산출물 push
 // - repoPath: gitbook 저장소 경로
+// - paths: 스테이징할 산출물 (저장소 기준, manifest, 모두 생성 파일이라 충돌 시 이번 실행 결과로 덮어씀)
 // - commitMsg: 커밋 메시지
+// - regenerate: 충돌을 덮어쓴 뒤 목차/피드/대시보드 등 전체 기록 산출물을 다시 만드는 훅 (nil이면 생략)
 // 반환: 에러 (없으면 nil)
-func PushGitbookAssets(repoPath, commitMsg string) error {
+func PushGitbookAssets(repoPath string, paths []string, commitMsg string, regenerate Regenerator) error {
 	log.Println("[PushGitbookAssets] === gitbook(submodule) push 시작 ===")
-	cmds := [][]string{
-		{"-C", repoPath, "add", ".gitbook/assets/graph.png"},
-		{"-C", repoPath, "add", ".gitbook/assets/timeslot-images.png"},
-		{"-C", repoPath, "commit", "-m", commitMsg},
-		{"-C", repoPath, "pull", "--rebase", "origin", "main"},
-		{"-C", repoPath, "push", "--no-verify", "origin", "HEAD:main"},
-	}
-	for _, args := range cmds {
-		if err := GitRun(args...); err != nil {
-			return fmt.Errorf("[gitbook push 단계] %w", err)
-		}
+	policy := conflictPolicy{regenerable: paths, regenerate: regenerate}
+	if err := commitAndPush(repoPath, paths, commitMsg, "main", policy); err != nil {
+		return fmt.Errorf("[gitbook push 단계] %w", err)
 	}
 	log.Println("[PushGitbookAssets] === gitbook(submodule) push 끝 ===")
 	return nil
 }
 
 // PushMainAssets: main repo에 데이터/이미지 push
-// - dateStr: 날짜 문자열 (예: "2024-06-01")
-// - jsonRelPath: JSON 상대 경로
+// - paths: 스테이징할 산출물 (현재 디렉토리 기준, manifest)
+// - regenerable: 충돌 시 이번 실행 결과로 덮어쓸 경로 (raw 데이터 충돌은 rebase를 취소하고 에러)
+// - submodules: 충돌 시 맞출 서브모듈 경로 → 커밋 (gitbook 서브모듈 포인터)
 // - commitMsg: 커밋 메시지
 // 반환: 에러 (없으면 nil)
-func PushMainAssets(dateStr, jsonRelPath, commitMsg string) error {
+func PushMainAssets(paths, regenerable []string, submodules map[string]string, commitMsg string) error {
 	log.Println("[PushMainAssets] === main push 시작 ===")
-	cmds := [][]string{
-		{"add", "."},
-		{"commit", "-m", commitMsg},
-		{"pull", "--rebase", "origin", "main"},
-		{"push", "--no-verify", "origin", "HEAD:main"},
+	policy := conflictPolicy{regenerable: regenerable, submodules: submodules}
+	if err := commitAndPush("", paths, commitMsg, "main", policy); err != nil {
+		return fmt.Errorf("[main push 단계] %w", err)
+	}
+	log.Println("[PushMainAssets] === main push 끝 ===")
+	return nil
+}
+
+// commitAndPush: paths를 커밋하고 origin/branch 위로 rebase해 push (여러 번 실행해도 결과가 같음)
+// - 바뀐 파일이 없으면 커밋을 건너뛰고, origin보다 앞선 커밋이 없으면 push도 건너뜀
+// - non-fast-forward로 거부되면 backoff 후 fetch부터 다시 시도
+// - policy: rebase 충돌 처리 방식
+func commitAndPush(dir string, paths []string, commitMsg, branch string, policy conflictPolicy) error {
+	if err := abortRebase(dir); err != nil {
+		return err
 	}
-	for _, args := range cmds {
+	if _, err := stageAndCommit(dir, paths, commitMsg); err != nil {
+		return err
+	}
+	upstream := "origin/" + branch
+	for attempt := 1; ; attempt++ {
+		if err := GitRun(gitArgs(dir, "fetch", "origin", branch)...); err != nil {
+			return err
+		}
+		if err := rebaseAndRegenerate(dir, upstream, commitMsg, policy); err != nil {
+			return err
+		}
+		ahead, err := GitOutput(gitArgs(dir, "rev-list", "--count", upstream+"..HEAD")...)
+		if err != nil {
+			return err
+		}
+		if ahead == "0" {
+			log.Printf("[git] %s: push할 커밋 없음", upstream)
+			return nil
+		}
+		if beforePush != nil {
+			beforePush(dir)
+		}
+		out, err := gitRunOutput(gitArgs(dir, "push", "--no-verify", "origin", "HEAD:refs/heads/"+branch)...)
+		if err == nil {
+			return nil
+		}
+		if !isNonFastForward(out) || attempt >= pushAttempts {
+			return err
+		}
+		wait := pushBackoff << (attempt - 1)
+		log.Printf("[git] non-fast-forward 거부, %s 후 재시도 (%d/%d)", wait, attempt, pushAttempts)
+		time.Sleep(wait)
+	}
+}
+
+// stageAndCommit: paths를 스테이징하고 바뀐 내용이 있을 때만 커밋
+// 반환: 커밋 여부, 에러
+func stageAndCommit(dir string, paths []string, commitMsg string) (bool, error) {
+	if len(paths) == 0 {
+		log.Println("[git] 스테이징할 산출물 없음")
+		return false, nil
+	}
+	for _, args := range addArgs(dir, paths) {
 		if err := GitRun(args...); err != nil {
-			return fmt.Errorf("[main push 단계] %w", err)
+			return false, err
 		}
 	}
-	log.Println("[PushMainAssets] === main push 끝 ===")
-	return nil
+	// diff --cached --quiet: 스테이징된 변경이 없으면 exit 0
+	diff := append(gitArgs(dir, "diff", "--cached", "--quiet", "--"), paths...)
+	if err := exec.Command("git", diff...).Run(); err == nil {
+		log.Println("[git] 바뀐 산출물 없음, 커밋 건너뜀")
+		return false, nil
+	}
+	if err := GitRun(append(gitArgs(dir, "commit", "-m", commitMsg, "--"), paths...)...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// rebaseAndRegenerate: upstream 위로 rebase하고, 충돌을 이번 실행 결과로 덮어썼으면 재생성 훅으로 다시 만들어 커밋
+// - 덮어쓴 목차/피드 등에는 다른 실행이 먼저 게시한 날짜가 빠져 있으므로 합쳐진 기록으로 다시 만듦
+func rebaseAndRegenerate(dir, upstream, commitMsg string, policy conflictPolicy) error {
+	resolved, err := rebaseOnto(dir, upstream, policy.regenerable, policy.submodules)
+	if err != nil || len(resolved) == 0 || policy.regenerate == nil {
+		return err
+	}
+	log.Printf("[git] 충돌 산출물을 합쳐진 기록으로 다시 생성: %v", resolved)
+	paths, err := policy.regenerate()
+	if err != nil {
+		return fmt.Errorf("충돌 산출물 재생성 실패: %w", err)
+	}
+	_, err = stageAndCommit(dir, paths, commitMsg)
+	return err
+}
+
+// rebaseOnto: upstream 위로 rebase (생성 산출물 충돌은 이번 실행의 파일로 해결, 그 밖의 충돌은 rebase를 취소하고 에러)
+// - 커밋하지 않은 다른 변경은 autostash로 보존
+// - regenerable: 자동 해결 대상 경로 (저장소 기준)
+// - submodules: 충돌 시 index를 이 커밋으로 맞출 서브모듈 경로 → 커밋
+// 반환: 이번 실행 결과로 덮어쓴 파일 (/ 구분, 서브모듈 제외), 에러
+func rebaseOnto(dir, upstream string, regenerable []string, submodules map[string]string) ([]string, error) {
+	allowed := map[string]bool{}
+	for _, p := range regenerable {
+		allowed[filepath.ToSlash(p)] = true
+	}
+	pins := map[string]string{}
+	for p, sha := range submodules {
+		pins[filepath.ToSlash(p)] = sha
+	}
+	var resolved []string
+	_, err := gitRunOutput(gitArgs(dir, "rebase", "--autostash", upstream)...)
+	for step := 0; err != nil; step++ {
+		conflicts, cerr := GitOutput(gitArgs(dir, "-c", "core.quotepath=false", "diff", "--name-only", "--diff-filter=U")...)
+		inProgress, rerr := rebaseInProgress(dir)
+		if cerr != nil || rerr != nil || !inProgress || step >= maxRebaseSteps {
+			break
+		}
+		var files []string
+		if conflicts != "" {
+			files = strings.Split(conflicts, "\n")
+		}
+		var regenerated []string
+		for _, f := range files {
+			if sha, ok := pins[f]; ok {
+				log.Printf("[git] 서브모듈 포인터 충돌을 push한 커밋으로 해결: %s → %s", f, sha)
+				if err := GitRun(gitArgs(dir, "update-index", "--cacheinfo", "160000,"+sha+","+f)...); err != nil {
+					abortRebase(dir)
+					return nil, err
+				}
+				continue
+			}
+			regenerated = append(regenerated, f)
+			if !allowed[f] {
+				abortRebase(dir)
+				return nil, fmt.Errorf("%s rebase 충돌 (생성 산출물이 아님: %s): %w", upstream, f, err)
+			}
+		}
+		if len(files) == 0 {
+			// 충돌 없이 멈춤 (이미 반영된 커밋 등) → 건너뜀
+			_, err = gitRunOutput(gitArgs(dir, "rebase", "--skip")...)
+			continue
+		}
+		if len(regenerated) > 0 {
+			log.Printf("[git] 생성 산출물 충돌을 이번 실행 결과로 해결: %v", regenerated)
+			// rebase 중 --theirs는 다시 적용 중인 우리 커밋 쪽
+			resolve := append(gitArgs(dir, "checkout", "--theirs", "--"), regenerated...)
+			if err := GitRun(resolve...); err != nil {
+				abortRebase(dir)
+				return nil, err
+			}
+			if err := GitRun(append(gitArgs(dir, "add", "--"), regenerated...)...); err != nil {
+				abortRebase(dir)
+				return nil, err
+			}
+			resolved = append(resolved, regenerated...)
+		}
+		_, err = gitRunOutput(gitArgs(dir, "-c", "core.editor=true", "rebase", "--continue")...)
+	}
+	if err != nil {
+		abortRebase(dir)
+		return nil, fmt.Errorf("%s rebase 실패: %w", upstream, err)
+	}
+	return resolved, nil
+}
+
+// rebaseInProgress: dir 저장소가 rebase 도중인지 확인
+func rebaseInProgress(dir string) (bool, error) {
+	for _, name := range []string{"rebase-merge", "rebase-apply"} {
+		path, err := GitOutput(gitArgs(dir, "rev-parse", "--git-path", name)...)
+		if err != nil {
+			return false, err
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// abortRebase: 중단된 rebase가 있으면 취소해 작업 트리를 rebase 전으로 되돌림
+func abortRebase(dir string) error {
+	inProgress, err := rebaseInProgress(dir)
+	if err != nil || !inProgress {
+		return err
+	}
+	log.Printf("[git] 중단된 rebase 취소 (%s)", dir)
+	return GitRun(gitArgs(dir, "rebase", "--abort")...)
+}
+
+// isNonFastForward: push 출력이 원격이 앞서 있어서 거부된 경우인지
+func isNonFastForward(out string) bool {
+	return strings.Contains(out, "non-fast-forward") || strings.Contains(out, "fetch first")
 }
@@ -0,0 +1,175 @@
+package exporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ManifestFile: Extract가 남기는 산출물 목록 기본 경로 (main repo 기준, 커밋하지 않음)
+const ManifestFile = "focus-manifest.json"
+
+// ArtifactRepo: 산출물이 커밋될 저장소
+type ArtifactRepo string
+
+const (
+	RepoMain    ArtifactRepo = "main"    // 현재 디렉토리 저장소 (dailydata)
+	RepoGitbook ArtifactRepo = "gitbook" // GitBook 저장소 (그래프, 대시보드, 리포트)
+)
+
+// ArtifactKind: 산출물 종류
+type ArtifactKind string
+
+const (
+	KindData      ArtifactKind = "data"            // 일별 FocusData JSON
+	KindGraph     ArtifactKind = "graph"           // 최근 7일 트렌드/회귀선
+	KindTimeSlot  ArtifactKind = "timeslot"        // 시간대별 평균 몰입도
+	KindTimeline  ArtifactKind = "timeline"        // 하루 타임라인
+	KindShare     ArtifactKind = "category-share"  // 카테고리 누적 영역
+	KindBoxPlot   ArtifactKind = "boxplot"         // 분포 박스 플롯
+	KindDashboard ArtifactKind = "dashboard"       // 대시보드 이미지
+	KindCalendar  ArtifactKind = "calendar"        // 달력 히트맵
+	KindHeatmap   ArtifactKind = "weekday-heatmap" // 요일×시간대 히트맵
+	KindSite      ArtifactKind = "site"            // 인터랙티브 HTML 대시보드
+	KindReport    ArtifactKind = "report"          // Markdown 리포트, 리포트 차트, 목차, Atom 피드
+)
+
+// Artifact: 산출물 파일 하나
+// - Path: 저장소 기준 상대 경로 (/ 구분)
+// - SHA256, Size: 생성 직후 내용 (push 전에 다시 확인)
+type Artifact struct {
+	Repo   ArtifactRepo `json:"repo"`
+	Kind   ArtifactKind `json:"kind"`
+	Path   string       `json:"path"`
+	SHA256 string       `json:"sha256"`
+	Size   int64        `json:"size"`
+}
+
+// Manifest: 한 번의 Extract가 만든 산출물 목록 (push 단계는 이 파일들만 스테이징)
+// - GitbookRepo: gitbook 저장소 경로 (RepoGitbook 산출물의 기준 경로)
+// - AssetsDir: gitbook 저장소 안의 그래프 디렉토리 (게시 중 충돌 산출물을 다시 만들 때 사용)
+// - Pruned: 보존 정책으로 삭제한 main repo 파일 (/ 구분, 삭제도 같은 커밋에 포함)
+type Manifest struct {
+	Date        string     `json:"date"`
+	JSONPath    string     `json:"jsonPath"`
+	CommitMsg   string     `json:"commitMsg"`
+	GitbookRepo string     `json:"gitbookRepo"`
+	AssetsDir   string     `json:"assetsDir,omitempty"`
+	GeneratedAt time.Time  `json:"generatedAt"`
+	Artifacts   []Artifact `json:"artifacts"`
+	Pruned      []string   `json:"pruned,omitempty"`
+}
+
+// NewManifest: 빈 산출물 목록 생성
+func NewManifest(date, jsonPath, commitMsg, gitbookRepo string, now time.Time) *Manifest {
+	return &Manifest{Date: date, JSONPath: jsonPath, CommitMsg: commitMsg, GitbookRepo: gitbookRepo, GeneratedAt: now}
+}
+
+// root: repo 산출물의 기준 경로 (main은 현재 디렉토리)
+func (m *Manifest) root(repo ArtifactRepo) string {
+	if repo == RepoGitbook {
+		return m.GitbookRepo
+	}
+	return ""
+}
+
+// Add: 디스크에 쓴 파일을 체크섬과 함께 목록에 추가 (같은 경로는 덮어씀)
+// - path: 실제 파일 경로 (gitbook 산출물은 GitbookRepo를 포함한 경로)
+func (m *Manifest) Add(repo ArtifactRepo, kind ArtifactKind, path string) error {
+	rel, err := filepath.Rel(filepath.Join(m.root(repo), "."), path)
+	rel = filepath.ToSlash(rel)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return fmt.Errorf("산출물 %s가 %s 저장소 밖에 있습니다", path, repo)
+	}
+	sum, size, err := fileChecksum(path)
+	if err != nil {
+		return err
+	}
+	a := Artifact{Repo: repo, Kind: kind, Path: rel, SHA256: sum, Size: size}
+	for i := range m.Artifacts {
+		if m.Artifacts[i].Repo == repo && m.Artifacts[i].Path == a.Path {
+			m.Artifacts[i] = a
+			return nil
+		}
+	}
+	m.Artifacts = append(m.Artifacts, a)
+	return nil
+}
+
+// Paths: repo 산출물의 저장소 기준 경로 (정렬)
+func (m *Manifest) Paths(repo ArtifactRepo) []string {
+	var paths []string
+	for _, a := range m.Artifacts {
+		if a.Repo == repo {
+			paths = append(paths, filepath.FromSlash(a.Path))
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Files: repo 저장소의 kind 산출물 실제 파일 경로 (기록 순)
+func (m *Manifest) Files(repo ArtifactRepo, kind ArtifactKind) []string {
+	var files []string
+	for _, a := range m.Artifacts {
+		if a.Repo == repo && a.Kind == kind {
+			files = append(files, filepath.Join(m.root(repo), filepath.FromSlash(a.Path)))
+		}
+	}
+	return files
+}
+
+// Verify: 산출물이 생성 직후 그대로인지 확인 (없거나 내용이 바뀌었으면 에러)
+func (m *Manifest) Verify() error {
+	for _, a := range m.Artifacts {
+		sum, _, err := fileChecksum(filepath.Join(m.root(a.Repo), filepath.FromSlash(a.Path)))
+		if err != nil {
+			return err
+		}
+		if sum != a.SHA256 {
+			return fmt.Errorf("산출물 %s:%s 내용이 manifest와 다릅니다 (Extract 이후 변경됨)", a.Repo, a.Path)
+		}
+	}
+	return nil
+}
+
+// Save: manifest를 JSON 파일로 저장
+func (m *Manifest) Save(path string) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("manifest 인코딩 실패: %w", err)
+	}
+	if err := WriteFile(path, append(b, '\n')); err != nil {
+		return fmt.Errorf("manifest 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// LoadManifest: Save로 저장한 manifest 읽기
+func LoadManifest(path string) (*Manifest, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("manifest 읽기 실패: %w", err)
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("manifest 파싱 실패 (%s): %w", path, err)
+	}
+	return m, nil
+}
+
+// fileChecksum: 파일 SHA-256 (hex)과 크기
+func fileChecksum(path string) (string, int64, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("산출물 읽기 실패: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), int64(len(b)), nil
+}
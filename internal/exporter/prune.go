@@ -0,0 +1,158 @@
+package exporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PruneDirs: 보존 정책을 적용하는 main repo 디렉토리 (날짜별 이미지만, dailydata/raw는 절대 포함하지 않음)
+var PruneDirs = []string{
+	filepath.Join("dailydata", "images"),
+	filepath.Join("dailydata", "timeslot-images"),
+	filepath.Join("dailydata", "timeline-images"),
+}
+
+// pruneExts: 정리 대상 확장자 (그래프 출력 포맷, JSON 등 그 밖의 파일은 건드리지 않음)
+var pruneExts = map[string]bool{".png": true, ".svg": true, ".pdf": true, ".eps": true}
+
+// RetentionPolicy: 날짜별 이미지 보존 정책 (기준일부터 과거로 차례대로 적용, 어느 구간에도 안 남으면 삭제)
+// - DailyDays: 최근 며칠은 모두 보존 (기준일 포함)
+// - WeeklyWeeks: 그 이전 몇 주는 ISO 주마다 가장 늦은 날짜 하나만 보존 (달의 마지막 날짜도 보존)
+// - MonthlyMonths: 그 이전 몇 달은 달마다 가장 늦은 날짜 하나만 보존
+type RetentionPolicy struct {
+	DailyDays     int
+	WeeklyWeeks   int
+	MonthlyMonths int
+}
+
+// DefaultRetention: 기본 보존 정책 (30일 전부, 12주 주간, 12개월 월간)
+var DefaultRetention = RetentionPolicy{DailyDays: 30, WeeklyWeeks: 12, MonthlyMonths: 12}
+
+// ParseRetention: "30d,12w,12m" 형식 → RetentionPolicy (빈 문자열이면 DefaultRetention, 빠진 구간은 0)
+// 반환: 정책, 에러 (형식 오류, 일간 보존 1일 미만)
+func ParseRetention(s string) (RetentionPolicy, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultRetention, nil
+	}
+	p := RetentionPolicy{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part[:len(part)-1])
+		if err != nil || n < 0 {
+			return RetentionPolicy{}, fmt.Errorf("보존 정책 형식 오류: %q (예: 30d,12w,12m)", part)
+		}
+		switch part[len(part)-1] {
+		case 'd':
+			p.DailyDays = n
+		case 'w':
+			p.WeeklyWeeks = n
+		case 'm':
+			p.MonthlyMonths = n
+		default:
+			return RetentionPolicy{}, fmt.Errorf("보존 정책 형식 오류: %q (예: 30d,12w,12m)", part)
+		}
+	}
+	if p.DailyDays < 1 {
+		return RetentionPolicy{}, fmt.Errorf("일간 보존 기간은 1일 이상이어야 합니다: %q", s)
+	}
+	return p, nil
+}
+
+// String: ParseRetention 형식 문자열
+func (p RetentionPolicy) String() string {
+	return fmt.Sprintf("%dd,%dw,%dm", p.DailyDays, p.WeeklyWeeks, p.MonthlyMonths)
+}
+
+// keep: 날짜 목록 중 보존할 날짜 집합
+// - today: 기준일 (이 날짜가 일간 구간의 첫날)
+func (p RetentionPolicy) keep(dates []time.Time, today time.Time) map[time.Time]bool {
+	dailyFrom := today.AddDate(0, 0, -(p.DailyDays - 1))
+	weeklyFrom := dailyFrom.AddDate(0, 0, -7*p.WeeklyWeeks)
+	monthlyFrom := weeklyFrom.AddDate(0, -p.MonthlyMonths, 0)
+
+	// 주/달마다 가장 늦은 날짜 (dates는 오름차순이므로 마지막 값이 남음)
+	lastOfWeek, lastOfMonth := map[string]time.Time{}, map[string]time.Time{}
+	for _, d := range dates {
+		year, week := d.ISOWeek()
+		lastOfWeek[fmt.Sprintf("%d-W%02d", year, week)] = d
+		lastOfMonth[d.Format("2006-01")] = d
+	}
+
+	kept := map[time.Time]bool{}
+	for _, d := range dates {
+		year, week := d.ISOWeek()
+		switch {
+		case !d.Before(dailyFrom):
+			kept[d] = true
+		case !d.Before(weeklyFrom):
+			// 달의 마지막 날이 주간 구간에 들면 주 마지막이 아니어도 남김 (빠지면 그 달 스냅샷이 사라짐)
+			kept[d] = lastOfWeek[fmt.Sprintf("%d-W%02d", year, week)].Equal(d) || lastOfMonth[d.Format("2006-01")].Equal(d)
+		case !d.Before(monthlyFrom):
+			kept[d] = lastOfMonth[d.Format("2006-01")].Equal(d)
+		}
+	}
+	return kept
+}
+
+// Prune: dirs의 날짜별 이미지(2006-01-02.png 등) 중 보존 정책에 안 남는 파일 삭제
+// - today: 보존 기준일 (시각은 무시)
+// - dryRun: true면 삭제하지 않고 대상만 반환
+// - 날짜 이름이 아니거나 이미지가 아닌 파일, dailydata/raw는 건드리지 않음
+// 반환: 삭제한(dryRun이면 삭제할) 파일 경로 목록 (/ 구분, 정렬), 에러
+func Prune(dirs []string, policy RetentionPolicy, today time.Time, dryRun bool) ([]string, error) {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	removed := []string{}
+	for _, dir := range dirs {
+		if filepath.Clean(dir) == filepath.Join("dailydata", "raw") {
+			return nil, fmt.Errorf("원본 데이터 디렉토리 %s는 정리할 수 없습니다", dir)
+		}
+		files, err := filepath.Glob(filepath.Join(dir, "*"))
+		if err != nil {
+			return nil, fmt.Errorf("%s 검색 실패: %w", dir, err)
+		}
+		byDate := map[time.Time][]string{}
+		dates := []time.Time{}
+		for _, f := range files {
+			ext := filepath.Ext(f)
+			if !pruneExts[strings.ToLower(ext)] {
+				continue
+			}
+			d, err := time.Parse("2006-01-02", strings.TrimSuffix(filepath.Base(f), ext))
+			if err != nil {
+				continue
+			}
+			if byDate[d] == nil {
+				dates = append(dates, d)
+			}
+			byDate[d] = append(byDate[d], f)
+		}
+		sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+		kept := policy.keep(dates, today)
+		for _, d := range dates {
+			if kept[d] {
+				continue
+			}
+			for _, f := range byDate[d] {
+				if !dryRun {
+					if err := os.Remove(f); err != nil {
+						return nil, fmt.Errorf("파일 삭제 실패: %w", err)
+					}
+					log.Printf("[Prune] 삭제: %s", f)
+				}
+				removed = append(removed, filepath.ToSlash(f))
+			}
+		}
+	}
+	sort.Strings(removed)
+	return removed, nil
+}
@@ -0,0 +1,232 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/github"
+)
+
+// PublishMode: 산출물 게시 방식
+type PublishMode string
+
+const (
+	PublishModePush PublishMode = "push" // main에 직접 push (기존 방식)
+	PublishModePR   PublishMode = "pr"   // 날짜 브랜치 push 후 PR 생성 (선택적으로 자동 머지)
+)
+
+// ParsePublishMode: 문자열 → PublishMode (빈 문자열은 push)
+func ParsePublishMode(s string) (PublishMode, error) {
+	m := PublishMode(strings.ToLower(strings.TrimSpace(s)))
+	switch m {
+	case "":
+		return PublishModePush, nil
+	case PublishModePush, PublishModePR:
+		return m, nil
+	}
+	return "", fmt.Errorf("지원하지 않는 게시 방식: %q (push, pr)", s)
+}
+
+// PROptions: PR 게시 옵션
+// - APIURL: GitHub REST API 주소 (비면 github.DefaultBaseURL)
+// - Token: API 토큰 (config.Env.GH_TOKEN)
+// - Base: PR 대상 브랜치 (비면 main)
+// - BranchPrefix: 날짜 브랜치 접두어 (비면 "focus/", 예: focus/2025-05-07)
+// - AutoMerge: true면 체크가 모두 통과한 뒤 머지하고 브랜치 삭제
+// - RequiredChecks: 자동 머지 전에 반드시 통과해야 하는 체크 이름 (비면 등록된 체크 전체)
+// - CheckGrace: push 후 체크가 하나도 등록되지 않았을 때 기다리는 시간 (0이면 2분, 지나도 없으면 체크 없는 저장소로 보고 머지)
+// - MergeMethod: merge, squash, rebase (비면 squash)
+// - CheckTimeout: 체크 대기 최대 시간 (0이면 30분)
+// - PollInterval: 체크 조회 간격 (0이면 15초)
+type PROptions struct {
+	APIURL         string
+	Token          string
+	Base           string
+	BranchPrefix   string
+	AutoMerge      bool
+	RequiredChecks []string
+	MergeMethod    string
+	CheckGrace     time.Duration
+	CheckTimeout   time.Duration
+	PollInterval   time.Duration
+}
+
+// withDefaults: 비어 있는 옵션을 기본값으로 채움
+func (o PROptions) withDefaults() PROptions {
+	if o.Base == "" {
+		o.Base = "main"
+	}
+	if o.BranchPrefix == "" {
+		o.BranchPrefix = "focus/"
+	}
+	if o.MergeMethod == "" {
+		o.MergeMethod = "squash"
+	}
+	if o.CheckGrace <= 0 {
+		o.CheckGrace = 2 * time.Minute
+	}
+	if o.CheckTimeout <= 0 {
+		o.CheckTimeout = 30 * time.Minute
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = 15 * time.Second
+	}
+	return o
+}
+
+// PublishPR: manifest의 gitbook repo, main repo 산출물을 각각 날짜 브랜치로 push하고 PR 생성
+// - m: Extract 산출물 목록 (날짜는 브랜치 이름, 커밋 메시지는 PR 제목으로 사용)
+// - main PR에는 gitbook 서브모듈 포인터를 넣지 않음 (gitbook PR 커밋은 squash 머지 후 main에 남지 않으므로, 머지 뒤 서브모듈을 따로 갱신)
+// - regenerate: gitbook rebase 충돌을 덮어쓴 뒤 전체 기록 산출물을 다시 만드는 훅 (nil이면 생략)
+// 반환: 생성(또는 기존) PR 목록 (gitbook, main 순, 산출물이 없는 저장소는 제외), 에러
+func PublishPR(ctx context.Context, m *Manifest, opts PROptions, regenerate Regenerator) ([]*github.PullRequest, error) {
+	if err := m.Verify(); err != nil {
+		return nil, err
+	}
+	opts = opts.withDefaults()
+	client := github.NewClient(opts.APIURL, opts.Token)
+	branch := opts.BranchPrefix + m.Date
+	body := fmt.Sprintf("%s 집중도 데이터 자동 게시\n\n- 데이터: `%s`\n- 산출물: %d개", m.Date, m.JSONPath, len(m.Artifacts))
+	mainBody := body
+	if sub := submodulePaths(m.GitbookRepo); len(sub) > 0 {
+		mainBody += fmt.Sprintf("\n- `%s` 서브모듈 포인터는 이 PR에 포함하지 않음 (gitbook PR 머지 후 서브모듈을 갱신해 따로 커밋)", sub[0])
+	}
+
+	var prs []*github.PullRequest
+	for _, stage := range []struct {
+		label, dir string
+		paths      []string
+		policy     conflictPolicy
+		body       string
+	}{
+		{"gitbook", m.GitbookRepo, m.Paths(RepoGitbook), conflictPolicy{regenerable: m.Paths(RepoGitbook), regenerate: regenerate}, body},
+		{"main", "", prMainPaths(m), conflictPolicy{regenerable: regenerablePaths(m, RepoMain)}, mainBody},
+	} {
+		if len(stage.paths) == 0 {
+			log.Printf("[PublishPR] %s 산출물 없음, 건너뜀", stage.label)
+			continue
+		}
+		pr, err := publishRepoPR(ctx, client, stage.dir, stage.paths, stage.policy, branch, m.CommitMsg, stage.body, opts)
+		if pr != nil {
+			prs = append(prs, pr)
+		}
+		if err != nil {
+			return prs, fmt.Errorf("[%s PR 단계] %w", stage.label, err)
+		}
+	}
+	return prs, nil
+}
+
+// prMainPaths: main PR에 커밋할 경로 (manifest 산출물 + 정리한 파일 삭제, gitbook 서브모듈 포인터 제외)
+func prMainPaths(m *Manifest) []string {
+	return append(m.Paths(RepoMain), trackedPaths(m.Pruned)...)
+}
+
+// pushPRBranch: 현재 브랜치(branch)에 paths를 커밋하고 origin/base 위로 rebase해 강제 push
+// 반환: push한 HEAD 커밋 (base보다 앞선 커밋이 없으면 ""), 에러
+func pushPRBranch(dir string, paths []string, policy conflictPolicy, branch, title, base string) (string, error) {
+	if _, err := stageAndCommit(dir, paths, title); err != nil {
+		return "", err
+	}
+	upstream := "origin/" + base
+	if err := GitRun(gitArgs(dir, "fetch", "origin", base)...); err != nil {
+		return "", err
+	}
+	if err := rebaseAndRegenerate(dir, upstream, title, policy); err != nil {
+		return "", err
+	}
+	ahead, err := GitOutput(gitArgs(dir, "rev-list", "--count", upstream+"..HEAD")...)
+	if err != nil || ahead == "0" {
+		return "", err
+	}
+	if err := GitRun(gitArgs(dir, "push", "--no-verify", "--force", "origin", "HEAD:refs/heads/"+branch)...); err != nil {
+		return "", err
+	}
+	return GitOutput(gitArgs(dir, "rev-parse", "HEAD")...)
+}
+
+// returnToBase: base 브랜치로 돌아간 뒤 branch에 커밋한 paths를 작업 트리에만 되살림
+// - extract 직후처럼 이번 실행 산출물이 커밋되지 않은 변경으로 남음 (다음 게시/로컬 확인에서 그대로 보임)
+func returnToBase(dir, base, branch string, paths []string) error {
+	if err := GitRun(gitArgs(dir, "checkout", base)...); err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return GitRun(append(gitArgs(dir, "restore", "--source="+branch, "--worktree", "--"), paths...)...)
+}
+
+// publishRepoPR: 저장소 하나에 대해 브랜치 생성~push~PR 생성~(자동 머지)까지 수행하고 base 브랜치로 복귀 (산출물은 작업 트리에 유지)
+// - dir: 저장소 경로 (비면 현재 디렉토리)
+// - paths: 커밋할 pathspec
+// - policy: rebase 충돌 처리 방식
+// 반환: PR (base 대비 바뀐 산출물이 없으면 nil), 에러
+func publishRepoPR(ctx context.Context, client *github.Client, dir string, paths []string, policy conflictPolicy, branch, title, body string, opts PROptions) (*github.PullRequest, error) {
+	remote, err := GitOutput(gitArgs(dir, "remote", "get-url", "origin")...)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := github.ParseRepo(remote)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[PublishPR] %s: %s → %s", repo, branch, opts.Base)
+
+	if err := abortRebase(dir); err != nil {
+		return nil, err
+	}
+	if err := GitRun(gitArgs(dir, "checkout", "-B", branch)...); err != nil {
+		return nil, err
+	}
+	sha, err := pushPRBranch(dir, paths, policy, branch, title, opts.Base)
+	if cerr := returnToBase(dir, opts.Base, branch, paths); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sha == "" {
+		log.Printf("[PublishPR] %s: %s 대비 바뀐 산출물 없음, PR 건너뜀", repo, opts.Base)
+		return nil, nil
+	}
+
+	pr, err := client.EnsurePullRequest(ctx, repo, github.NewPullRequest{Title: title, Head: branch, Base: opts.Base, Body: body})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[PublishPR] PR #%d: %s", pr.Number, pr.HTMLURL)
+	if !opts.AutoMerge {
+		return pr, nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, opts.CheckTimeout)
+	defer cancel()
+	state, err := client.WaitForChecks(waitCtx, repo, sha, github.WaitOptions{
+		Interval: opts.PollInterval,
+		Grace:    opts.CheckGrace,
+		Required: opts.RequiredChecks,
+	})
+	if err != nil {
+		return pr, err
+	}
+	switch state {
+	case github.CheckSuccess:
+	case github.CheckNone:
+		log.Printf("[PublishPR] PR #%d: %s 동안 등록된 체크 없음, 체크 없는 저장소로 보고 머지", pr.Number, opts.CheckGrace)
+	default:
+		return pr, fmt.Errorf("PR #%d 체크 실패로 자동 머지하지 않음", pr.Number)
+	}
+	if err := client.MergePullRequest(ctx, repo, pr.Number, opts.MergeMethod, sha); err != nil {
+		return pr, err
+	}
+	pr.Merged = true
+	if err := client.DeleteBranch(ctx, repo, branch); err != nil {
+		log.Printf("[PublishPR] 머지 후 브랜치 삭제 실패 (무시): %v", err)
+	}
+	log.Printf("[PublishPR] PR #%d 머지 완료", pr.Number)
+	return pr, nil
+}
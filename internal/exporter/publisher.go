@@ -0,0 +1,277 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/crispy/focus-time-tracker/internal/github"
+)
+
+// 게시 백엔드 이름 (PUBLISHER 환경변수, --publisher 플래그)
+const (
+	PublisherGit    = "git"     // gitbook/main repo에 commit 후 push 또는 PR (PUBLISH_MODE)
+	PublisherLocal  = "local"   // 산출물을 로컬 디렉토리로 복사만 함
+	PublisherDryRun = "dry-run" // 바뀔 파일과 diff 요약만 출력
+)
+
+// PublishResult: 게시 결과
+// - Files: 바뀌었거나 복사된 파일 (git 백엔드는 비어 있음)
+// - PullRequests: PR 모드에서 생성(또는 기존) PR
+type PublishResult struct {
+	Files        []string
+	PullRequests []*github.PullRequest
+}
+
+// Publisher: 추출 산출물 게시 백엔드 (manifest에 적힌 파일만 다룸)
+type Publisher interface {
+	Name() string
+	Publish(ctx context.Context, m *Manifest) (*PublishResult, error)
+}
+
+// PublisherOptions: NewPublisher 옵션
+// - Mode, PR: git 백엔드의 게시 방식 (push, pr)과 PR 옵션
+// - History: git 백엔드가 gitbook 충돌 뒤 전체 기록 산출물을 다시 만들 때 쓰는 Extract 옵션 (nil이면 이번 실행 결과 그대로)
+// - LocalDir: local 백엔드의 복사 대상 디렉토리
+// - Out: dry-run 백엔드의 출력 대상 (nil이면 표준 출력)
+type PublisherOptions struct {
+	Mode     PublishMode
+	PR       PROptions
+	History  *ExtractOptions
+	LocalDir string
+	Out      io.Writer
+}
+
+// NewPublisher: 이름으로 게시 백엔드 생성 (빈 문자열은 git)
+// 반환: Publisher, 에러 (알 수 없는 이름, local인데 디렉토리 없음)
+func NewPublisher(name string, opts PublisherOptions) (Publisher, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", PublisherGit:
+		return &GitPublisher{Mode: opts.Mode, PR: opts.PR, History: opts.History}, nil
+	case PublisherLocal:
+		if opts.LocalDir == "" {
+			return nil, fmt.Errorf("local 게시에는 복사할 디렉토리가 필요합니다")
+		}
+		return &LocalPublisher{Dir: opts.LocalDir}, nil
+	case PublisherDryRun:
+		out := opts.Out
+		if out == nil {
+			out = os.Stdout
+		}
+		return &DryRunPublisher{Out: out}, nil
+	}
+	return nil, fmt.Errorf("지원하지 않는 게시 백엔드: %q (%s, %s, %s)", name, PublisherGit, PublisherLocal, PublisherDryRun)
+}
+
+// GitPublisher: 기존 git 게시 (Mode가 pr이면 날짜 브랜치 PR, 아니면 main 직접 push)
+// - History: rebase 충돌 뒤 전체 기록 산출물 재생성 옵션 (PublisherOptions 참고)
+type GitPublisher struct {
+	Mode    PublishMode
+	PR      PROptions
+	History *ExtractOptions
+}
+
+func (p *GitPublisher) Name() string { return PublisherGit }
+
+func (p *GitPublisher) Publish(ctx context.Context, m *Manifest) (*PublishResult, error) {
+	var regenerate Regenerator
+	if p.History != nil {
+		regenerate = HistoryRegenerator(m, *p.History)
+	}
+	if p.Mode != PublishModePR {
+		return &PublishResult{}, Push(m, regenerate)
+	}
+	prs, err := PublishPR(ctx, m, p.PR, regenerate)
+	return &PublishResult{PullRequests: prs}, err
+}
+
+// LocalPublisher: manifest 산출물을 Dir/gitbook, Dir/main 아래 같은 상대 경로로 복사 (내용이 같은 파일은 건너뜀, 정리한 파일은 복사본도 삭제)
+type LocalPublisher struct {
+	Dir string
+}
+
+func (p *LocalPublisher) Name() string { return PublisherLocal }
+
+func (p *LocalPublisher) Publish(ctx context.Context, m *Manifest) (*PublishResult, error) {
+	if err := m.Verify(); err != nil {
+		return nil, err
+	}
+	result := &PublishResult{}
+	for _, a := range m.Artifacts {
+		dst := filepath.Join(p.Dir, string(a.Repo), filepath.FromSlash(a.Path))
+		copied, err := copyIfChanged(filepath.Join(m.root(a.Repo), filepath.FromSlash(a.Path)), dst)
+		if err != nil {
+			return result, err
+		}
+		if copied {
+			result.Files = append(result.Files, dst)
+		}
+	}
+	for _, path := range m.Pruned {
+		dst := filepath.Join(p.Dir, string(RepoMain), filepath.FromSlash(path))
+		if err := os.Remove(dst); err == nil {
+			result.Files = append(result.Files, dst)
+		} else if !os.IsNotExist(err) {
+			return result, fmt.Errorf("정리한 파일 삭제 실패: %w", err)
+		}
+	}
+	log.Printf("[LocalPublisher] %s: %d개 파일 복사", p.Dir, len(result.Files))
+	return result, nil
+}
+
+// copyIfChanged: src를 dst로 복사 (dst 내용이 같으면 건너뜀)
+// 반환: 복사 여부, 에러
+func copyIfChanged(src, dst string) (bool, error) {
+	b, err := os.ReadFile(src)
+	if err != nil {
+		return false, fmt.Errorf("산출물 읽기 실패: %w", err)
+	}
+	if old, err := os.ReadFile(dst); err == nil && bytes.Equal(old, b) {
+		return false, nil
+	}
+	if err := WriteFile(dst, b); err != nil {
+		return false, fmt.Errorf("산출물 복사 실패 (%s): %w", dst, err)
+	}
+	return true, nil
+}
+
+// DryRunPublisher: git 백엔드가 커밋할 파일과 diff 요약만 출력 (저장소는 건드리지 않음)
+type DryRunPublisher struct {
+	Out io.Writer
+}
+
+func (p *DryRunPublisher) Name() string { return PublisherDryRun }
+
+func (p *DryRunPublisher) Publish(ctx context.Context, m *Manifest) (*PublishResult, error) {
+	result := &PublishResult{}
+	fmt.Fprintf(p.Out, "[dry-run] 커밋 메시지: %s (산출물 %d개)\n", m.CommitMsg, len(m.Artifacts))
+	if err := m.Verify(); err != nil {
+		fmt.Fprintf(p.Out, "[dry-run] 경고: %v\n", err)
+	}
+	for _, repo := range []struct {
+		label, dir string
+		paths      []string
+	}{
+		{"gitbook", m.GitbookRepo, m.Paths(RepoGitbook)},
+		{"main", "", mainStagePaths(m)},
+	} {
+		if len(repo.paths) == 0 {
+			writeChanges(p.Out, repo.label, nil)
+			continue
+		}
+		changes, err := pendingChanges(repo.dir, repo.paths)
+		if err != nil {
+			return result, fmt.Errorf("[%s dry-run] %w", repo.label, err)
+		}
+		writeChanges(p.Out, repo.label, changes)
+		for _, c := range changes {
+			result.Files = append(result.Files, filepath.Join(repo.dir, c.Path))
+		}
+	}
+	return result, nil
+}
+
+// fileChange: 커밋될 파일 하나의 변경 요약
+// - Status: git status 코드 (A 새 파일, M 수정, D 삭제 등)
+// - Added, Deleted: 추가/삭제 줄 수 (Binary면 의미 없음)
+type fileChange struct {
+	Status  string
+	Path    string
+	Added   int
+	Deleted int
+	Binary  bool
+}
+
+// pendingChanges: dir 저장소에서 paths를 git add 했을 때 커밋될 변경 목록
+func pendingChanges(dir string, paths []string) ([]fileChange, error) {
+	statusArgs := append(gitArgs(dir, "-c", "core.quotepath=false", "status", "--porcelain=v2", "--untracked-files=all", "--"), paths...)
+	status, err := GitOutput(statusArgs...)
+	if err != nil {
+		return nil, err
+	}
+	numstatArgs := append(gitArgs(dir, "-c", "core.quotepath=false", "diff", "--numstat", "HEAD", "--"), paths...)
+	numstat, err := GitOutput(numstatArgs...)
+	if err != nil {
+		return nil, err
+	}
+	stats := map[string]fileChange{}
+	for _, line := range strings.Split(numstat, "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		added, errA := strconv.Atoi(fields[0])
+		deleted, errD := strconv.Atoi(fields[1])
+		stats[fields[2]] = fileChange{Added: added, Deleted: deleted, Binary: errA != nil || errD != nil}
+	}
+
+	// porcelain v2: "1 XY ... path", "2 XY ... path\torig" (이름 변경), "? path" (추적 안 됨)
+	var changes []fileChange
+	for _, line := range strings.Split(status, "\n") {
+		var code, path string
+		switch {
+		case strings.HasPrefix(line, "? "):
+			code, path = "A", line[2:]
+		case strings.HasPrefix(line, "1 "):
+			if f := strings.SplitN(line, " ", 9); len(f) == 9 {
+				code, path = f[1], f[8]
+			}
+		case strings.HasPrefix(line, "2 "):
+			if f := strings.SplitN(line, " ", 10); len(f) == 10 {
+				code, path = f[1], strings.SplitN(f[9], "\t", 2)[0]
+			}
+		}
+		if path == "" {
+			continue
+		}
+		c, ok := stats[path]
+		if code == "A" {
+			c, ok = untrackedStat(filepath.Join(dir, path)), true
+		}
+		if !ok {
+			continue
+		}
+		c.Status, c.Path = strings.Trim(code, ".")[:1], path
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+// untrackedStat: 새 파일의 줄 수 (NUL 바이트가 있으면 바이너리)
+func untrackedStat(path string) fileChange {
+	b, err := os.ReadFile(path)
+	if err != nil || bytes.IndexByte(b, 0) >= 0 {
+		return fileChange{Binary: true}
+	}
+	n := bytes.Count(b, []byte("\n"))
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		n++
+	}
+	return fileChange{Added: n}
+}
+
+// writeChanges: 저장소별 변경 목록과 git diff --stat 형태의 합계 출력
+func writeChanges(w io.Writer, label string, changes []fileChange) {
+	if len(changes) == 0 {
+		fmt.Fprintf(w, "[%s] 변경 없음\n", label)
+		return
+	}
+	fmt.Fprintf(w, "[%s] %d개 파일 변경 예정\n", label, len(changes))
+	added, deleted := 0, 0
+	for _, c := range changes {
+		if c.Binary {
+			fmt.Fprintf(w, "  %s %s (binary)\n", c.Status, c.Path)
+			continue
+		}
+		fmt.Fprintf(w, "  %s %s (+%d -%d)\n", c.Status, c.Path, c.Added, c.Deleted)
+		added += c.Added
+		deleted += c.Deleted
+	}
+	fmt.Fprintf(w, "  %d files changed, %d insertions(+), %d deletions(-)\n", len(changes), added, deleted)
+}
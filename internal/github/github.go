@@ -0,0 +1,360 @@
+// Package github: PR 기반 게시에 필요한 최소한의 GitHub REST API 클라이언트
+// - PR 생성/조회, 커밋 체크 상태 확인, 머지, 브랜치 삭제
+// - BaseURL을 바꿔 GitHub Enterprise나 테스트용 가짜 서버에 연결 가능
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL: github.com REST API 주소
+const DefaultBaseURL = "https://api.github.com"
+
+// Client: GitHub REST API 클라이언트
+// - BaseURL: API 주소 (끝의 / 무시)
+// - Token: 인증 토큰 (비면 인증 헤더 없음)
+// - HTTP: 요청에 쓸 http.Client
+type Client struct {
+	BaseURL string
+	Token   string
+	HTTP    *http.Client
+}
+
+// NewClient: 클라이언트 생성 (baseURL이 비면 DefaultBaseURL)
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Token:   token,
+		HTTP:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Repo: 저장소 식별자 (owner/name)
+type Repo struct {
+	Owner string
+	Name  string
+}
+
+func (r Repo) String() string {
+	return r.Owner + "/" + r.Name
+}
+
+// repoURLPattern: https://host/owner/name(.git), git@host:owner/name(.git), ssh://git@host/owner/name(.git)
+var repoURLPattern = regexp.MustCompile(`[:/]([^/:]+)/([^/]+?)(\.git)?/?$`)
+
+// ParseRepo: git remote URL 또는 "owner/name" → Repo
+// 반환: Repo, 에러 (형식을 알 수 없을 때)
+func ParseRepo(s string) (Repo, error) {
+	s = strings.TrimSpace(s)
+	if parts := strings.Split(s, "/"); len(parts) == 2 && !strings.Contains(s, ":") {
+		if parts[0] != "" && parts[1] != "" {
+			return Repo{Owner: parts[0], Name: strings.TrimSuffix(parts[1], ".git")}, nil
+		}
+	}
+	m := repoURLPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Repo{}, fmt.Errorf("저장소 주소를 해석할 수 없습니다: %q", s)
+	}
+	return Repo{Owner: m[1], Name: m[2]}, nil
+}
+
+// APIError: 2xx가 아닌 API 응답
+type APIError struct {
+	StatusCode int
+	Message    string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("GitHub API %d: %s", e.StatusCode, e.Message)
+}
+
+// do: JSON 요청/응답 처리
+// - body: 요청 본문 (nil이면 없음), out: 응답을 디코딩할 값 (nil이면 무시)
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("요청 인코딩 실패: %w", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s 요청 실패: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		b, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(b, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = strings.TrimSpace(string(b))
+		}
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%s %s 응답 디코딩 실패: %w", method, path, err)
+	}
+	return nil
+}
+
+// PullRequest: PR 응답 중 사용하는 필드
+type PullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	State   string `json:"state"`
+	Merged  bool   `json:"merged"`
+	Head    struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"head"`
+}
+
+// NewPullRequest: PR 생성 요청
+type NewPullRequest struct {
+	Title string `json:"title"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Body  string `json:"body,omitempty"`
+}
+
+// CreatePullRequest: PR 생성
+func (c *Client) CreatePullRequest(ctx context.Context, repo Repo, pr NewPullRequest) (*PullRequest, error) {
+	out := &PullRequest{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/pulls", repo), pr, out); err != nil {
+		return nil, fmt.Errorf("PR 생성 실패 (%s %s→%s): %w", repo, pr.Head, pr.Base, err)
+	}
+	return out, nil
+}
+
+// FindPullRequest: head 브랜치 → base로 열린 PR 조회
+// 반환: PR (없으면 nil), 에러
+func (c *Client) FindPullRequest(ctx context.Context, repo Repo, head, base string) (*PullRequest, error) {
+	q := url.Values{"state": {"open"}, "head": {repo.Owner + ":" + head}, "base": {base}}
+	var prs []PullRequest
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/repos/%s/pulls?%s", repo, q.Encode()), nil, &prs); err != nil {
+		return nil, fmt.Errorf("PR 조회 실패 (%s %s): %w", repo, head, err)
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+	return &prs[0], nil
+}
+
+// EnsurePullRequest: head → base로 열린 PR이 있으면 그대로, 없으면 새로 생성
+func (c *Client) EnsurePullRequest(ctx context.Context, repo Repo, pr NewPullRequest) (*PullRequest, error) {
+	existing, err := c.FindPullRequest(ctx, repo, pr.Head, pr.Base)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+	return c.CreatePullRequest(ctx, repo, pr)
+}
+
+// CheckState: 커밋 체크(check run + commit status) 종합 상태
+type CheckState string
+
+const (
+	CheckNone    CheckState = "none" // 등록된 체크가 아직 없음 (CI가 곧 등록하거나 CI가 없는 저장소)
+	CheckPending CheckState = "pending"
+	CheckSuccess CheckState = "success"
+	CheckFailure CheckState = "failure"
+)
+
+// checkPageSize: check run/status 한 페이지 크기 (API 최대값)
+const checkPageSize = 100
+
+// checkRun: check run 응답 중 사용하는 필드
+type checkRun struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+}
+
+// checkRuns: ref 커밋의 모든 check run (페이지를 끝까지 조회)
+func (c *Client) checkRuns(ctx context.Context, repo Repo, ref string) ([]checkRun, error) {
+	var all []checkRun
+	for page := 1; ; page++ {
+		var resp struct {
+			TotalCount int        `json:"total_count"`
+			CheckRuns  []checkRun `json:"check_runs"`
+		}
+		path := fmt.Sprintf("/repos/%s/commits/%s/check-runs?per_page=%d&page=%d", repo, ref, checkPageSize, page)
+		if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, fmt.Errorf("check run 조회 실패: %w", err)
+		}
+		all = append(all, resp.CheckRuns...)
+		if len(resp.CheckRuns) < checkPageSize || len(all) >= resp.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+// combinedStatus: ref 커밋의 commit status 종합 상태와 context 이름 (페이지를 끝까지 조회)
+// 반환: 종합 상태, status 수, context 이름, 에러
+func (c *Client) combinedStatus(ctx context.Context, repo Repo, ref string) (string, int, []string, error) {
+	state, total, contexts := "", 0, []string{}
+	for page := 1; ; page++ {
+		var resp struct {
+			State      string `json:"state"`
+			TotalCount int    `json:"total_count"`
+			Statuses   []struct {
+				Context string `json:"context"`
+			} `json:"statuses"`
+		}
+		path := fmt.Sprintf("/repos/%s/commits/%s/status?per_page=%d&page=%d", repo, ref, checkPageSize, page)
+		if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return "", 0, nil, fmt.Errorf("commit status 조회 실패: %w", err)
+		}
+		if page == 1 {
+			state, total = resp.State, resp.TotalCount
+		}
+		for _, st := range resp.Statuses {
+			contexts = append(contexts, st.Context)
+		}
+		if len(resp.Statuses) < checkPageSize || len(contexts) >= total {
+			return state, total, contexts, nil
+		}
+	}
+}
+
+// Checks: ref 커밋의 check run과 commit status를 합친 상태
+// - required: 반드시 있어야 하는 체크 이름 (check run 이름 또는 status context, 아직 없으면 대기)
+// - 하나라도 실패면 실패, 진행 중이거나 required가 빠져 있으면 대기, 체크가 하나도 없으면 CheckNone
+func (c *Client) Checks(ctx context.Context, repo Repo, ref string, required []string) (CheckState, error) {
+	runs, err := c.checkRuns(ctx, repo, ref)
+	if err != nil {
+		return "", err
+	}
+	statusState, statusCount, contexts, err := c.combinedStatus(ctx, repo, ref)
+	if err != nil {
+		return "", err
+	}
+
+	state := CheckSuccess
+	seen := map[string]bool{}
+	for _, r := range runs {
+		seen[r.Name] = true
+		if r.Status != "completed" {
+			state = CheckPending
+			continue
+		}
+		switch r.Conclusion {
+		case "success", "neutral", "skipped":
+		default:
+			return CheckFailure, nil
+		}
+	}
+	for _, name := range contexts {
+		seen[name] = true
+	}
+	if statusCount > 0 {
+		switch statusState {
+		case "failure", "error":
+			return CheckFailure, nil
+		case "pending":
+			state = CheckPending
+		}
+	}
+	for _, name := range required {
+		if !seen[name] {
+			return CheckPending, nil
+		}
+	}
+	if len(runs) == 0 && statusCount == 0 {
+		return CheckNone, nil
+	}
+	return state, nil
+}
+
+// WaitOptions: 체크 대기 옵션
+// - Interval: 조회 간격
+// - Grace: 체크가 하나도 없을 때 CI가 체크를 등록하기를 기다리는 시간 (push 직후에는 아직 없음)
+// - Required: 반드시 있어야 하는 체크 이름 (Checks 참고)
+type WaitOptions struct {
+	Interval time.Duration
+	Grace    time.Duration
+	Required []string
+}
+
+// WaitForChecks: 체크가 끝날 때까지 Interval마다 조회 (ctx 만료 시 에러)
+// - Grace 동안 체크가 하나도 없으면 계속 대기, 그 뒤에도 없으면 CheckNone 반환 (체크 없는 저장소)
+// 반환: 최종 상태 (성공/실패/없음), 에러
+func (c *Client) WaitForChecks(ctx context.Context, repo Repo, ref string, opts WaitOptions) (CheckState, error) {
+	start := time.Now()
+	for {
+		state, err := c.Checks(ctx, repo, ref, opts.Required)
+		if err != nil {
+			return "", err
+		}
+		if state == CheckNone && time.Since(start) >= opts.Grace {
+			return CheckNone, nil
+		}
+		if state != CheckPending && state != CheckNone {
+			return state, nil
+		}
+		select {
+		case <-ctx.Done():
+			return CheckPending, fmt.Errorf("체크 대기 시간 초과 (%s@%s): %w", repo, ref, ctx.Err())
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// MergePullRequest: PR 머지
+// - method: merge, squash, rebase (비면 merge)
+// - sha: head가 이 커밋일 때만 머지 (비면 확인 안 함)
+func (c *Client) MergePullRequest(ctx context.Context, repo Repo, number int, method, sha string) error {
+	if method == "" {
+		method = "merge"
+	}
+	body := map[string]string{"merge_method": method}
+	if sha != "" {
+		body["sha"] = sha
+	}
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("/repos/%s/pulls/%d/merge", repo, number), body, nil); err != nil {
+		return fmt.Errorf("PR #%d 머지 실패: %w", number, err)
+	}
+	return nil
+}
+
+// DeleteBranch: 원격 브랜치 삭제 (머지 후 정리)
+func (c *Client) DeleteBranch(ctx context.Context, repo Repo, branch string) error {
+	if err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/repos/%s/git/refs/heads/%s", repo, branch), nil, nil); err != nil {
+		return fmt.Errorf("브랜치 %s 삭제 실패: %w", branch, err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Package i18n: 그래프/CLI/대시보드에 표시되는 문구의 메시지 카탈로그 (ko, en)
+// - 데이터(JSON, 시트)의 카테고리 키는 항상 한국어 ID를 쓰고, 표시할 때만 Category로 변환
+package i18n
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+)
+
+// Locale: 표시 언어
+type Locale string
+
+const (
+	Korean  Locale = "ko"
+	English Locale = "en"
+)
+
+// DefaultLocale: 설정이 없을 때 쓰는 언어
+const DefaultLocale = Korean
+
+// ParseLocale: 문자열 → Locale 변환 (대소문자/지역 코드 무시, 빈 문자열은 DefaultLocale)
+// - 예: "en", "en_US", "EN-us", "ko-KR"
+// 반환: Locale, 에러 (지원하지 않는 언어)
+func ParseLocale(s string) (Locale, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(s, "-_."); i >= 0 {
+		s = s[:i]
+	}
+	switch Locale(s) {
+	case "":
+		return DefaultLocale, nil
+	case Korean, English:
+		return Locale(s), nil
+	}
+	return "", fmt.Errorf("지원하지 않는 언어: %q (ko, en)", s)
+}
+
+// LocaleFromArgs: 전역 플래그(서브커맨드 앞)의 --lang 값 → Locale (없으면 fallback)
+// - 플래그 설명 문구를 만들기 전에 언어를 정하려고 flag.Parse보다 먼저 읽음
+// - 지원 형식: -lang en, --lang en, -lang=en, --lang=en
+// 반환: Locale, 에러 (지원하지 않는 언어)
+func LocaleFromArgs(args []string, fallback string) (Locale, error) {
+	value := fallback
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			break // 서브커맨드부터는 전역 플래그가 아님
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == "lang" && i+1 < len(args) {
+			value = args[i+1]
+			i++
+		} else if strings.HasPrefix(name, "lang=") {
+			value = strings.TrimPrefix(name, "lang=")
+		}
+	}
+	return ParseLocale(value)
+}
+
+// Printer: 한 언어로 문구/카테고리/날짜를 만드는 값 (0값은 DefaultLocale)
+type Printer struct {
+	locale Locale
+}
+
+// New: locale용 Printer 생성 (지원하지 않는 값이면 DefaultLocale)
+func New(locale Locale) Printer {
+	if _, ok := catalog[locale]; !ok {
+		locale = DefaultLocale
+	}
+	return Printer{locale: locale}
+}
+
+// Locale: Printer의 언어
+func (p Printer) Locale() Locale {
+	if p.locale == "" {
+		return DefaultLocale
+	}
+	return p.locale
+}
+
+// T: 메시지 키 → 현재 언어 문구 (args가 있으면 fmt.Sprintf 형식으로 채움)
+// - 현재 언어에 없는 키는 DefaultLocale 문구, 그것도 없으면 키 자체를 사용
+func (p Printer) T(key string, args ...interface{}) string {
+	msg, ok := catalog[p.Locale()][key]
+	if !ok {
+		if msg, ok = catalog[DefaultLocale][key]; !ok {
+			msg = key
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Category: 카테고리 ID(한국어) → 표시 이름 (카테고리 정의의 언어별 이름, 없으면 ID 그대로)
+func (p Printer) Category(id string) string {
+	return common.CategoryName(id, string(p.Locale()))
+}
+
+// Weekday: 요일 약칭 (ko: "월", en: "Mon")
+func (p Printer) Weekday(w time.Weekday) string {
+	if p.Locale() == English {
+		return w.String()[:3]
+	}
+	return koWeekdays[w]
+}
+
+// Month: 월 약칭 (ko: "5월", en: "May")
+func (p Printer) Month(m time.Month) string {
+	if p.Locale() == English {
+		return m.String()[:3]
+	}
+	return fmt.Sprintf("%d월", int(m))
+}
+
+// Date: 연/월/일 날짜 (ko: "2025년 5월 7일", en: "May 7, 2025")
+func (p Printer) Date(t time.Time) string {
+	if p.Locale() == English {
+		return t.Format("Jan 2, 2006")
+	}
+	return fmt.Sprintf("%d년 %d월 %d일", t.Year(), int(t.Month()), t.Day())
+}
+
+// ShortDate: 연도 없는 날짜 (ko: "5/7(수)", en: "Wed 5/7")
+func (p Printer) ShortDate(t time.Time) string {
+	if p.Locale() == English {
+		return fmt.Sprintf("%s %d/%d", p.Weekday(t.Weekday()), int(t.Month()), t.Day())
+	}
+	return fmt.Sprintf("%d/%d(%s)", int(t.Month()), t.Day(), p.Weekday(t.Weekday()))
+}
+
+// DateRange: "2006-01-02" 형식 두 날짜 → 기간 문구 (파싱 실패 시 원본 문자열 사용)
+// - ko: "2025년 5월 1일 ~ 5월 7일", en: "May 1 - May 7, 2025" (연도가 다르면 둘 다 연도 표시)
+func (p Printer) DateRange(from, to string) string {
+	f, errF := time.Parse("2006-01-02", from)
+	t, errT := time.Parse("2006-01-02", to)
+	if errF != nil || errT != nil {
+		return from + " ~ " + to
+	}
+	if p.Locale() == English {
+		if f.Year() == t.Year() {
+			return f.Format("Jan 2") + " - " + p.Date(t)
+		}
+		return p.Date(f) + " - " + p.Date(t)
+	}
+	if f.Year() == t.Year() {
+		return p.Date(f) + " ~ " + fmt.Sprintf("%d월 %d일", int(t.Month()), t.Day())
+	}
+	return p.Date(f) + " ~ " + p.Date(t)
+}
+
+// koWeekdays: 한국어 요일 약칭
+var koWeekdays = [7]string{"일", "월", "화", "수", "목", "금", "토"}
+
+// defaultPrinter: CLI 등 옵션을 따로 넘기지 않는 곳에서 쓰는 Printer (SetDefault로 변경)
+var defaultPrinter = New(DefaultLocale)
+
+// SetDefault: 기본 Printer 언어 변경 (CLI 시작 시 설정/플래그로 한 번 호출)
+func SetDefault(locale Locale) {
+	defaultPrinter = New(locale)
+}
+
+// Default: 기본 Printer
+func Default() Printer {
+	return defaultPrinter
+}
+
+// T: 기본 Printer로 메시지 키 → 문구
+func T(key string, args ...interface{}) string {
+	return defaultPrinter.T(key, args...)
+}
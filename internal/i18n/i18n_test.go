@@ -0,0 +1,102 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCatalogKeysMatch(t *testing.T) {
+	for locale, msgs := range catalog {
+		for key := range catalog[DefaultLocale] {
+			if _, ok := msgs[key]; !ok {
+				t.Errorf("%s 카탈로그에 %q 없음", locale, key)
+			}
+		}
+		for key := range msgs {
+			if _, ok := catalog[DefaultLocale][key]; !ok {
+				t.Errorf("%s 카탈로그의 %q가 기본 언어에 없음", locale, key)
+			}
+		}
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	cases := map[string]Locale{"": Korean, "ko": Korean, "ko-KR": Korean, "EN": English, "en_US.UTF-8": English}
+	for in, want := range cases {
+		got, err := ParseLocale(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLocale(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseLocale("ja"); err == nil {
+		t.Errorf("지원하지 않는 언어에 에러가 없음")
+	}
+}
+
+func TestLocaleFromArgs(t *testing.T) {
+	cases := []struct {
+		args     []string
+		fallback string
+		want     Locale
+	}{
+		{[]string{"--lang", "en", "extract"}, "ko", English},
+		{[]string{"-lang=en", "show"}, "", English},
+		{[]string{"extract", "--lang", "en"}, "ko", Korean}, // 서브커맨드 뒤는 무시
+		{[]string{"-h"}, "en_US", English},
+		{nil, "", Korean},
+	}
+	for _, c := range cases {
+		got, err := LocaleFromArgs(c.args, c.fallback)
+		if err != nil || got != c.want {
+			t.Errorf("LocaleFromArgs(%v, %q) = %q, %v, want %q", c.args, c.fallback, got, err, c.want)
+		}
+	}
+	if _, err := LocaleFromArgs([]string{"--lang", "ja"}, ""); err == nil {
+		t.Errorf("지원하지 않는 언어에 에러가 없음")
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	ko, en := New(Korean), New(English)
+	if got := en.T("calendar.cat", 2025, en.Category("업무")); got != "Daily efficiency (%) of Work, 2025" {
+		t.Errorf("en calendar.cat = %q", got)
+	}
+	if got := ko.T("calendar.cat", 2025, ko.Category("업무")); got != "2025년 일별 업무 효율(%)" {
+		t.Errorf("ko calendar.cat = %q", got)
+	}
+	if got := en.Category("새 카테고리"); got != "새 카테고리" {
+		t.Errorf("번역 없는 카테고리 = %q", got)
+	}
+	if got := en.T("no.such.key"); got != "no.such.key" {
+		t.Errorf("없는 키 = %q", got)
+	}
+	if (Printer{}).Locale() != DefaultLocale || New("ja").Locale() != DefaultLocale {
+		t.Errorf("0값/미지원 Printer가 기본 언어가 아님")
+	}
+}
+
+func TestDates(t *testing.T) {
+	d := time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC)
+	ko, en := New(Korean), New(English)
+	checks := map[string]string{
+		ko.Date(d):                               "2025년 5월 7일",
+		en.Date(d):                               "May 7, 2025",
+		ko.ShortDate(d):                          "5/7(수)",
+		en.ShortDate(d):                          "Wed 5/7",
+		ko.Month(d.Month()):                      "5월",
+		en.Month(d.Month()):                      "May",
+		ko.DateRange("2025-05-01", "2025-05-07"): "2025년 5월 1일 ~ 5월 7일",
+		en.DateRange("2024-12-30", "2025-01-02"): "Dec 30, 2024 - Jan 2, 2025",
+		en.DateRange("2025-05-01", "2025-05-07"): "May 1 - May 7, 2025",
+		ko.DateRange("Aggregate", "2025-05-07"):  "Aggregate ~ 2025-05-07",
+	}
+	for got, want := range checks {
+		if got != want {
+			t.Errorf("날짜 = %q, want %q", got, want)
+		}
+	}
+	if !strings.HasPrefix(T("trend.up"), "상승") {
+		t.Errorf("기본 Printer가 한국어가 아님")
+	}
+}
@@ -0,0 +1,281 @@
+package i18n
+
+// catalog: 언어별 메시지 (키는 "영역.이름" 형식, 문구는 fmt 형식 문자열)
+// - 새 문구는 ko/en 양쪽에 같은 키로 추가할 것 (테스트에서 키 누락 검사)
+var catalog = map[Locale]map[string]string{
+	Korean: {
+		// 공통 축/범례
+		"axis.date":            "일자",
+		"axis.hour":            "시간",
+		"axis.score":           "점수",
+		"axis.avgScore":        "평균 몰입 점수",
+		"axis.focusScore":      "몰입 점수",
+		"axis.efficiency":      "효율 (%)",
+		"axis.slots":           "슬롯 수",
+		"axis.ratio":           "비율 (%)",
+		"trend.up":             "상승",
+		"trend.down":           "감소",
+		"trend.flat":           "유지",
+		"trend.eval":           "%s: %.2f (%s)  ",
+		"trends.title":         "카테고리별 트렌드 및 회귀선",
+		"trends.today":         "%s (오늘)",
+		"trends.actual":        "%s(실제)",
+		"trends.reg":           "%s(회귀)",
+		"trends.average":       "전체 평균",
+		"timeslot.title":       "시간대별 일자별 평균 몰입 점수",
+		"timeslot.aggregate":   "기간 평균",
+		"calendar.total":       "%d년 일별 총 몰입 점수",
+		"calendar.cat":         "%d년 일별 %s 효율(%%)",
+		"calendar.legend":      "색상: %.0f(연함) ~ %.0f(진함), X: 기록 없음",
+		"share.slots":          "일자별 카테고리 기록 시간 (슬롯 수)",
+		"share.ratio":          "일자별 카테고리 기록 비율 (%)",
+		"heatmap.title":        "요일×시간대별 평균 몰입 점수",
+		"heatmap.cat":          "요일×시간대별 평균 몰입 점수 (%s)",
+		"heatmap.legend":       "시간 (색상: %.0f 낮음 → %.0f 높음, 회색: 기록 없음)",
+		"timeline.title":       "하루 타임라인 (색=카테고리, 높이=몰입 점수)",
+		"box.cat.title":        "카테고리별 일자 효율 분포 (%s)",
+		"box.cat.label":        "%s (%d일)",
+		"box.slot.title":       "시간대별 슬롯 점수 분포 (%s, 0점 제외)",
+		"dash.title":           "몰입도 대시보드  %s (%d일)",
+		"dash.best":            "%s (평균 효율 %.0f%%)",
+		"dash.stats":           "총 몰입 점수 %d · 최고 카테고리 %s · 딥워크 %.1f시간 (점수 %d 이상)",
+		"dash.generated":       " · 생성 %s",
+		"term.header":          "몰입도 %s (%d일)",
+		"term.category":        "카테고리",
+		"term.daily":           "일별 효율",
+		"term.avgEff":          "평균 효율",
+		"term.timeline":        "%s 타임라인 (위: 주 카테고리, 아래: 평균 점수)",
+		"term.hours":           "기록(h)",
+		"term.focus":           "점수 합",
+		"term.eff":             "효율",
+		"term.total":           "합계",
+		"site.title":           "몰입도 대시보드",
+		"site.meta":            "생성: %s · 기록 %d일",
+		"site.months":          "%d개월",
+		"site.all":             "전체",
+		"site.days":            "일",
+		"site.slopes":          "일별 기울기",
+		"site.noData":          "기록 없음",
+		"site.reg":             "(회귀)",
+		"site.trendTitle":      "카테고리별 효율(%) 트렌드 및 회귀선",
+		"site.hour":            "시",
+		"site.timeSlotTitle":   "시간대별 평균 몰입 점수",
+		"report.daily.title":   "%s (%s) 몰입 리포트",
+		"report.weekly.title":  "%d년 %d주차 리포트",
+		"report.period":        "기간: %s · 기록 %d일",
+		"report.total":         "총 몰입 점수: **%d**",
+		"report.charts":        "차트",
+		"report.chart.trends":  "카테고리별 트렌드 및 회귀선 (최근 %d일)",
+		"report.stats":         "카테고리별 통계",
+		"report.col.sum":       "점수 합",
+		"report.col.max":       "최대 점수",
+		"report.col.eff":       "효율",
+		"report.col.total":     "총 몰입 점수",
+		"report.noStats":       "기록된 카테고리가 없습니다.",
+		"report.days":          "일자별 기록",
+		"report.eval":          "트렌드 평가",
+		"report.evalNote":      "%d일간 카테고리 점수의 회귀 기울기 (1 초과 상승, -1 미만 감소)",
+		"report.index.title":   "몰입 리포트",
+		"report.index.empty":   "아직 리포트가 없습니다.",
+		"report.feed.title":    "몰입 리포트 피드",
+		"report.feed.summary":  "총 몰입 점수 %d",
+		"notify.title":         "%s 몰입 요약",
+		"notify.template":      "총 몰입 점수 {{.TotalFocus}}\n{{range .Categories}}• {{.Label}}: {{.Score}}\n{{end}}{{if .Eval}}\n최근 트렌드\n{{range .Eval}}• {{.}}\n{{end}}{{end}}",
+		"analysis.title":       "최근 %d일 분석",
+		"analysis.updated":     "갱신: %s",
+		"analysis.date":        "날짜",
+		"analysis.total":       "Focus 합계",
+		"analysis.rolling":     "%d일 평균",
+		"analysis.slope":       "%d일 기울기",
+		"analysis.zscore":      "z-score",
+		"analysis.anomaly":     "이상치",
+		"analysis.category":    "카테고리",
+		"analysis.high":        "▲ 높음",
+		"analysis.low":         "▼ 낮음",
+		"cli.usage":            "Usage: focus [--lang ko|en] extract [--publisher git|local|dry-run] [--dry-run] [--manifest path] [--prune] [--analysis] | push [--publisher git|local|dry-run] [--dry-run] [--manifest path] | prune [--dry-run] [--keep 30d,12w,12m] | site [-out path] | show [--days N] | report [-date YYYY-MM-DD] [-all]",
+		"cli.pushUsage":        "Usage: focus push [--publisher git|local|dry-run] [--dry-run] [--local-dir path] [--manifest path] [<dateStr> <jsonRelPath> <commitMsg>]",
+		"cli.pushDone":         "Push 완료!",
+		"cli.extractDone":      "추출 완료! dateStr: %s, jsonRelPath: %s, commitMsg: %s",
+		"cli.siteDone":         "대시보드 생성 완료: %s",
+		"cli.reportDone":       "리포트 %d일 생성 완료: %s",
+		"cli.flag.date":        "리포트 날짜 (YYYY-MM-DD, 비우면 마지막 기록일)",
+		"cli.flag.all":         "모든 기록일의 리포트를 다시 생성",
+		"cli.err.noData":       "%s에 FocusData가 없습니다",
+		"cli.err.report":       "리포트 생성 실패: %v",
+		"cli.prDone":           "PR #%d: %s (머지: %t)",
+		"cli.localDone":        "%d개 파일을 %s에 복사했습니다",
+		"cli.dryRunDone":       "dry-run: %d개 파일이 바뀔 예정 (저장소는 변경하지 않음)",
+		"cli.flag.publisher":   "게시 백엔드 (git, local, dry-run). 비우면 PUBLISHER 환경변수",
+		"cli.flag.dryRun":      "게시하지 않고 바뀔 파일과 diff 요약만 출력 (--publisher dry-run과 같음)",
+		"cli.flag.localDir":    "local 게시 백엔드의 복사 대상 디렉토리",
+		"cli.err.publisher":    "게시 백엔드 설정 오류: %v",
+		"cli.manifestDone":     "산출물 %d개를 %s에 기록했습니다",
+		"cli.flag.manifest":    "산출물 목록(manifest) 파일 경로",
+		"cli.err.manifest":     "manifest 로드 실패: %v",
+		"cli.err.manifestDate": "인자 날짜 %s가 manifest 날짜 %s와 다릅니다 (%s)",
+		"cli.notifyDone":       "%d개 채널에 요약을 보냈습니다",
+		"cli.err.notify":       "알림 전송 실패 (추출 결과에는 영향 없음): %v",
+		"cli.analysisDone":     "스프레드시트 %s 탭에 분석 결과를 썼습니다 (%d일)",
+		"cli.err.analysis":     "분석 탭 쓰기 실패 (추출 결과에는 영향 없음): %v",
+		"cli.analysisDryRun":   "dry-run: 스프레드시트 %s 탭은 쓰지 않음",
+		"cli.flag.analysis":    "추출 후 분석 결과(이동 평균, 기울기, 이상치, 최신 그래프)를 스프레드시트 분석 탭에 씀. 기본값 ANALYSIS_WRITEBACK",
+		"cli.pruneDone":        "오래된 이미지 %d개를 삭제했습니다 (보존 정책 %s)",
+		"cli.pruneDryRun":      "dry-run: 이미지 %d개가 삭제될 예정 (보존 정책 %s, 파일은 그대로 둠)",
+		"cli.flag.pruneDryRun": "삭제하지 않고 삭제할 파일만 출력",
+		"cli.flag.keep":        "보존 정책 (예: 30d,12w,12m). 비우면 RETENTION 환경변수",
+		"cli.flag.prune":       "추출 후 보존 정책으로 오래된 이미지를 정리하고 삭제도 함께 커밋. 기본값 PRUNE_AFTER_EXTRACT",
+		"cli.err.prune":        "이미지 정리 실패: %v",
+		"cli.flag.lang":        "표시 언어 (ko, en). 비우면 LOCALE 환경변수",
+		"cli.flag.out":         "대시보드 HTML 저장 경로",
+		"cli.flag.raw":         "FocusData JSON 디렉토리",
+		"cli.flag.days":        "표시할 최근 일수",
+		"cli.err.auth":         "Google Sheets API 인증 실패: %v",
+		"cli.err.env":          "%s 환경변수를 설정하세요.",
+		"cli.err.render":       "그래프 옵션 오류: %v",
+		"cli.err.dash":         "대시보드 옵션 오류: %v",
+		"cli.err.extract":      "Extract 실패: %v",
+		"cli.err.push":         "Push 실패: %v",
+		"cli.err.load":         "데이터 로드 실패: %v",
+		"cli.err.site":         "대시보드 생성 실패: %v",
+		"cli.err.show":         "출력 실패: %v",
+		"cli.err.locale":       "언어 설정 오류: %v",
+		"cli.err.categories":   "카테고리 설정 오류: %v",
+	},
+	English: {
+		"axis.date":            "Date",
+		"axis.hour":            "Hour",
+		"axis.score":           "Score",
+		"axis.avgScore":        "Average focus score",
+		"axis.focusScore":      "Focus score",
+		"axis.efficiency":      "Efficiency (%)",
+		"axis.slots":           "Slots",
+		"axis.ratio":           "Share (%)",
+		"trend.up":             "rising",
+		"trend.down":           "falling",
+		"trend.flat":           "steady",
+		"trend.eval":           "%s: %.2f (%s)  ",
+		"trends.title":         "Category trends and regression",
+		"trends.today":         "%s (today)",
+		"trends.actual":        "%s (actual)",
+		"trends.reg":           "%s (trend)",
+		"trends.average":       "Overall average",
+		"timeslot.title":       "Average focus score by time of day",
+		"timeslot.aggregate":   "Period average",
+		"calendar.total":       "Daily total focus score, %d",
+		"calendar.cat":         "Daily efficiency (%%) of %[2]s, %[1]d",
+		"calendar.legend":      "Color: %.0f (light) to %.0f (dark), X: no record",
+		"share.slots":          "Recorded time per category (slots)",
+		"share.ratio":          "Category share per day (%)",
+		"heatmap.title":        "Average focus score by weekday × hour",
+		"heatmap.cat":          "Average focus score by weekday × hour (%s)",
+		"heatmap.legend":       "Hour (color: %.0f low → %.0f high, gray: no record)",
+		"timeline.title":       "Daily timeline (color = category, height = focus score)",
+		"box.cat.title":        "Daily efficiency distribution by category (%s)",
+		"box.cat.label":        "%s (%d days)",
+		"box.slot.title":       "Slot score distribution by hour (%s, zeros excluded)",
+		"dash.title":           "Focus dashboard  %s (%d days)",
+		"dash.best":            "%s (avg efficiency %.0f%%)",
+		"dash.stats":           "Total focus %d · Best category %s · Deep work %.1fh (score %d+)",
+		"dash.generated":       " · generated %s",
+		"term.header":          "Focus %s (%d days)",
+		"term.category":        "Category",
+		"term.daily":           "Daily eff.",
+		"term.avgEff":          "Avg eff.",
+		"term.timeline":        "%s timeline (top: main category, bottom: avg score)",
+		"term.hours":           "Hours",
+		"term.focus":           "Focus",
+		"term.eff":             "Eff.",
+		"term.total":           "Total",
+		"site.title":           "Focus dashboard",
+		"site.meta":            "Generated: %s · %d days recorded",
+		"site.months":          "%d months",
+		"site.all":             "All",
+		"site.days":            " days",
+		"site.slopes":          "daily slope",
+		"site.noData":          "No records",
+		"site.reg":             " (trend)",
+		"site.trendTitle":      "Category efficiency (%) trends and regression",
+		"site.hour":            ":00",
+		"site.timeSlotTitle":   "Average focus score by hour",
+		"report.daily.title":   "Focus report for %s (%s)",
+		"report.weekly.title":  "Week %[2]d, %[1]d report",
+		"report.period":        "Period: %s · %d days recorded",
+		"report.total":         "Total focus score: **%d**",
+		"report.charts":        "Charts",
+		"report.chart.trends":  "Category trends and regression (last %d days)",
+		"report.stats":         "Category stats",
+		"report.col.sum":       "Score sum",
+		"report.col.max":       "Max score",
+		"report.col.eff":       "Efficiency",
+		"report.col.total":     "Total focus",
+		"report.noStats":       "No categories recorded.",
+		"report.days":          "Days",
+		"report.eval":          "Trend evaluation",
+		"report.evalNote":      "Regression slope of category scores over %d days (above 1 rising, below -1 falling)",
+		"report.index.title":   "Focus reports",
+		"report.index.empty":   "No reports yet.",
+		"report.feed.title":    "Focus report feed",
+		"report.feed.summary":  "Total focus score %d",
+		"notify.title":         "Focus summary for %s",
+		"notify.template":      "Total focus score {{.TotalFocus}}\n{{range .Categories}}• {{.Label}}: {{.Score}}\n{{end}}{{if .Eval}}\nRecent trends\n{{range .Eval}}• {{.}}\n{{end}}{{end}}",
+		"analysis.title":       "Analysis of the last %d days",
+		"analysis.updated":     "Updated: %s",
+		"analysis.date":        "Date",
+		"analysis.total":       "Total focus",
+		"analysis.rolling":     "%d-day average",
+		"analysis.slope":       "%d-day slope",
+		"analysis.zscore":      "z-score",
+		"analysis.anomaly":     "Anomaly",
+		"analysis.category":    "Category",
+		"analysis.high":        "▲ High",
+		"analysis.low":         "▼ Low",
+		"cli.usage":            "Usage: focus [--lang ko|en] extract [--publisher git|local|dry-run] [--dry-run] [--manifest path] [--prune] [--analysis] | push [--publisher git|local|dry-run] [--dry-run] [--manifest path] | prune [--dry-run] [--keep 30d,12w,12m] | site [-out path] | show [--days N] | report [-date YYYY-MM-DD] [-all]",
+		"cli.pushUsage":        "Usage: focus push [--publisher git|local|dry-run] [--dry-run] [--local-dir path] [--manifest path] [<dateStr> <jsonRelPath> <commitMsg>]",
+		"cli.pushDone":         "Push complete!",
+		"cli.extractDone":      "Extract complete! dateStr: %s, jsonRelPath: %s, commitMsg: %s",
+		"cli.siteDone":         "Dashboard generated: %s",
+		"cli.reportDone":       "Generated reports for %d days: %s",
+		"cli.flag.date":        "report date (YYYY-MM-DD); defaults to the last recorded day",
+		"cli.flag.all":         "regenerate reports for every recorded day",
+		"cli.err.noData":       "no FocusData in %s",
+		"cli.err.report":       "failed to generate reports: %v",
+		"cli.prDone":           "PR #%d: %s (merged: %t)",
+		"cli.localDone":        "Copied %d files to %s",
+		"cli.dryRunDone":       "dry-run: %d files would change (repositories left untouched)",
+		"cli.flag.publisher":   "publish backend (git, local, dry-run); defaults to PUBLISHER",
+		"cli.flag.dryRun":      "print the files that would change and a diff summary instead of publishing (same as --publisher dry-run)",
+		"cli.flag.localDir":    "target directory of the local publish backend",
+		"cli.err.publisher":    "Invalid publish backend: %v",
+		"cli.manifestDone":     "Recorded %d artifacts in %s",
+		"cli.flag.manifest":    "path of the artifact manifest file",
+		"cli.err.manifest":     "Failed to load manifest: %v",
+		"cli.err.manifestDate": "date argument %s does not match manifest date %s (%s)",
+		"cli.notifyDone":       "Sent the summary to %d channels",
+		"cli.err.notify":       "Notification failed (extraction unaffected): %v",
+		"cli.analysisDone":     "Wrote the analysis to the spreadsheet's %s tab (%d days)",
+		"cli.err.analysis":     "Writing the analysis tab failed (extraction unaffected): %v",
+		"cli.analysisDryRun":   "dry-run: skipped writing the spreadsheet's %s tab",
+		"cli.flag.analysis":    "after extracting, write the analysis (rolling averages, slopes, anomalies, latest chart) to the spreadsheet's analysis tab; defaults to ANALYSIS_WRITEBACK",
+		"cli.pruneDone":        "Deleted %d old images (retention %s)",
+		"cli.pruneDryRun":      "dry-run: %d images would be deleted (retention %s, files left untouched)",
+		"cli.flag.pruneDryRun": "print the files that would be deleted without deleting them",
+		"cli.flag.keep":        "retention policy (e.g. 30d,12w,12m); defaults to RETENTION",
+		"cli.flag.prune":       "prune old images by the retention policy after extracting and commit the deletions too; defaults to PRUNE_AFTER_EXTRACT",
+		"cli.err.prune":        "failed to prune images: %v",
+		"cli.flag.lang":        "display language (ko, en); defaults to LOCALE",
+		"cli.flag.out":         "output path of the dashboard HTML",
+		"cli.flag.raw":         "FocusData JSON directory",
+		"cli.flag.days":        "number of recent days to show",
+		"cli.err.auth":         "Google Sheets API authentication failed: %v",
+		"cli.err.env":          "Please set the %s environment variable.",
+		"cli.err.render":       "invalid chart options: %v",
+		"cli.err.dash":         "invalid dashboard options: %v",
+		"cli.err.extract":      "extract failed: %v",
+		"cli.err.push":         "push failed: %v",
+		"cli.err.load":         "failed to load data: %v",
+		"cli.err.site":         "failed to generate dashboard: %v",
+		"cli.err.show":         "failed to print: %v",
+		"cli.err.locale":       "invalid language: %v",
+		"cli.err.categories":   "invalid category config: %v",
+	},
+}
@@ -0,0 +1,195 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPConfig: 메일 백엔드 설정
+// - Port: 0이면 587
+// - Username: 비면 인증 없이 보냄 (로컬 릴레이, 테스트 서버)
+// - To: 받는 사람 목록
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// Email: SMTP 메일 백엔드 (본문 text/plain, 그래프는 첨부 파일)
+type Email struct {
+	Config SMTPConfig
+}
+
+// NewEmail: 메일 백엔드 생성
+func NewEmail(cfg SMTPConfig) *Email {
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &Email{Config: cfg}
+}
+
+func (e *Email) Name() string { return "email" }
+
+func (e *Email) Notify(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	body, err := buildMail(e.Config.From, e.Config.To, msg, time.Now())
+	if err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if e.Config.Username != "" {
+		auth = smtp.PlainAuth("", e.Config.Username, e.Config.Password, e.Config.Host)
+	}
+	addr := net.JoinHostPort(e.Config.Host, strconv.Itoa(e.Config.Port))
+	if err := sendMail(ctx, addr, e.Config.Host, auth, e.Config.From, e.Config.To, body); err != nil {
+		return fmt.Errorf("메일 전송 실패 (%s): %w", addr, err)
+	}
+	return nil
+}
+
+// sendMail: ctx를 따르는 smtp.SendMail (ctx 마감/취소 시 연결을 끊어 대기 중인 명령을 중단)
+// - 서버가 STARTTLS를 지원하면 TLS로 올리고, auth가 있으면 인증
+func sendMail(ctx context.Context, addr, host string, auth smtp.Auth, from string, to []string, body []byte) error {
+	dialer := net.Dialer{Timeout: 30 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return ctxErr(ctx, err)
+	}
+	defer c.Close()
+	if err := smtpSession(c, host, auth, from, to, body); err != nil {
+		return ctxErr(ctx, err)
+	}
+	return nil
+}
+
+// smtpSession: 연결된 클라이언트로 STARTTLS~인증~발신/수신자~본문~QUIT 수행
+func smtpSession(c *smtp.Client, host string, auth smtp.Auth, from string, to []string, body []byte) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("서버가 AUTH를 지원하지 않음")
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// ctxErr: ctx가 끝나 연결이 끊긴 경우 네트워크 에러 대신 ctx 에러를 함께 돌려줌
+func ctxErr(ctx context.Context, err error) error {
+	if cerr := ctx.Err(); cerr != nil {
+		return fmt.Errorf("%w (%v)", cerr, err)
+	}
+	return err
+}
+
+// mailPart: multipart 메일의 파트 하나 (본문은 base64로 씀)
+type mailPart struct {
+	header textproto.MIMEHeader
+	data   []byte
+}
+
+// buildMail: multipart/mixed 메일 (본문 + 첨부) 생성
+func buildMail(from string, to []string, msg Message, now time.Time) ([]byte, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	header := []string{
+		"From: " + from,
+		"To: " + strings.Join(to, ", "),
+		"Subject: " + mime.BEncoding.Encode("utf-8", msg.Title),
+		"Date: " + now.Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/mixed; boundary=" + w.Boundary(),
+	}
+	b.WriteString(strings.Join(header, "\r\n") + "\r\n\r\n")
+
+	parts := []mailPart{{
+		header: textproto.MIMEHeader{"Content-Type": {"text/plain; charset=utf-8"}},
+		data:   []byte(msg.Text),
+	}}
+	if msg.Image != nil {
+		parts = append(parts, mailPart{
+			header: textproto.MIMEHeader{
+				"Content-Type":        {msg.Image.ContentType},
+				"Content-Disposition": {mime.FormatMediaType("attachment", map[string]string{"filename": msg.Image.Name})},
+			},
+			data: msg.Image.Data,
+		})
+	}
+	for _, p := range parts {
+		p.header.Set("Content-Transfer-Encoding", "base64")
+		pw, err := w.CreatePart(p.header)
+		if err != nil {
+			return nil, fmt.Errorf("메일 본문 생성 실패: %w", err)
+		}
+		if _, err := pw.Write([]byte(wrapBase64(p.data))); err != nil {
+			return nil, fmt.Errorf("메일 본문 생성 실패: %w", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("메일 본문 생성 실패: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// wrapBase64: base64를 76자 줄로 나눔 (RFC 2045)
+func wrapBase64(data []byte) string {
+	enc := base64.StdEncoding.EncodeToString(data)
+	var b strings.Builder
+	for len(enc) > 76 {
+		b.WriteString(enc[:76] + "\r\n")
+		enc = enc[76:]
+	}
+	b.WriteString(enc + "\r\n")
+	return b.String()
+}
@@ -0,0 +1,168 @@
+// Package notify: 추출 후 일간 요약(합계, 트렌드 평가, 그래프)을 채팅/메일로 보내는 알림
+// - 일반 webhook(JSON), Slack incoming webhook, SMTP 메일 백엔드
+// - 본문은 text/template (기본 템플릿은 i18n 카탈로그의 notify.template)
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+// Category: 요약의 카테고리 한 줄 (점수 내림차순)
+type Category struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Score int    `json:"score"`
+}
+
+// Summary: 템플릿/webhook에 넘기는 하루 요약
+// - DateLabel: 언어별 날짜 표기 (예: 2025년 5월 6일)
+// - Eval: 카테고리별 트렌드 평가 (analyzer.EvalText를 항목별로 나눈 것)
+type Summary struct {
+	Date       string     `json:"date"`
+	DateLabel  string     `json:"dateLabel"`
+	TotalFocus int        `json:"totalFocus"`
+	Categories []Category `json:"categories"`
+	Eval       []string   `json:"eval"`
+}
+
+// NewSummary: 하루 FocusData와 트렌드 평가 문구로 요약 구성
+// - eval: analyzer.EvalText 결과 (항목은 공백 두 칸으로 구분)
+func NewSummary(day common.FocusData, eval string, tr i18n.Printer) Summary {
+	s := Summary{Date: day.Date, DateLabel: day.Date, TotalFocus: day.TotalFocus}
+	if t, err := time.Parse("2006-01-02", day.Date); err == nil {
+		s.DateLabel = tr.Date(t)
+	}
+	for name, score := range day.Categories {
+		s.Categories = append(s.Categories, Category{Name: name, Label: tr.Category(name), Score: score})
+	}
+	sort.Slice(s.Categories, func(i, j int) bool {
+		if s.Categories[i].Score != s.Categories[j].Score {
+			return s.Categories[i].Score > s.Categories[j].Score
+		}
+		return s.Categories[i].Name < s.Categories[j].Name
+	})
+	for _, item := range strings.Split(strings.TrimSpace(eval), "  ") {
+		if item = strings.TrimSpace(item); item != "" {
+			s.Eval = append(s.Eval, item)
+		}
+	}
+	return s
+}
+
+// Attachment: 메시지에 붙이는 파일 (그래프 이미지)
+type Attachment struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	Data        []byte `json:"data"`
+}
+
+// LoadAttachment: 파일을 첨부로 읽기 (Content-Type은 확장자로 판단)
+func LoadAttachment(path string) (*Attachment, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("첨부 파일 읽기 실패: %w", err)
+	}
+	ct := mime.TypeByExtension(filepath.Ext(path))
+	if ct == "" {
+		ct = "application/octet-stream"
+	}
+	return &Attachment{Name: filepath.Base(path), ContentType: ct, Data: b}, nil
+}
+
+// Message: 백엔드에 보내는 알림
+// - Title, Text: 템플릿으로 만든 제목/본문
+// - Image: 첨부 그래프 (nil이면 없음), ImageURL: 외부에서 볼 수 있는 그래프 주소 (Slack 이미지 블록용)
+type Message struct {
+	Title    string
+	Text     string
+	Summary  Summary
+	Image    *Attachment
+	ImageURL string
+}
+
+// ParseTemplate: 본문 템플릿 파싱
+// - path: 템플릿 파일 (비면 tr 언어의 기본 템플릿 notify.template)
+func ParseTemplate(path string, tr i18n.Printer) (*template.Template, error) {
+	text := tr.T("notify.template")
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("알림 템플릿 읽기 실패: %w", err)
+		}
+		text = string(b)
+	}
+	tmpl, err := template.New("notify").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("알림 템플릿 파싱 실패: %w", err)
+	}
+	return tmpl, nil
+}
+
+// NewMessage: 요약을 템플릿으로 렌더링해 메시지 구성 (제목은 notify.title)
+func NewMessage(s Summary, tmpl *template.Template, tr i18n.Printer) (Message, error) {
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, s); err != nil {
+		return Message{}, fmt.Errorf("알림 템플릿 실행 실패: %w", err)
+	}
+	return Message{
+		Title:   tr.T("notify.title", s.DateLabel),
+		Text:    strings.TrimSpace(b.String()),
+		Summary: s,
+	}, nil
+}
+
+// Notifier: 알림 백엔드
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Config: 백엔드 설정 (주소가 비어 있는 백엔드는 만들지 않음)
+type Config struct {
+	WebhookURL      string
+	SlackWebhookURL string
+	SMTP            SMTPConfig
+}
+
+// New: 설정된 백엔드 목록 (webhook, slack, email 순)
+func New(cfg Config) []Notifier {
+	var notifiers []Notifier
+	if cfg.WebhookURL != "" {
+		notifiers = append(notifiers, NewWebhook(cfg.WebhookURL))
+	}
+	if cfg.SlackWebhookURL != "" {
+		notifiers = append(notifiers, NewSlack(cfg.SlackWebhookURL))
+	}
+	if cfg.SMTP.Host != "" && len(cfg.SMTP.To) > 0 {
+		notifiers = append(notifiers, NewEmail(cfg.SMTP))
+	}
+	return notifiers
+}
+
+// Send: 모든 백엔드에 보내기 (하나가 실패해도 나머지는 계속 보냄)
+// 반환: 실패한 백엔드 에러를 합친 에러 (모두 성공하면 nil)
+func Send(ctx context.Context, notifiers []Notifier, msg Message) error {
+	var errs []error
+	for _, n := range notifiers {
+		if err := n.Notify(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("[%s] %w", n.Name(), err))
+			continue
+		}
+		log.Printf("[notify] %s 전송 완료", n.Name())
+	}
+	return errors.Join(errs...)
+}
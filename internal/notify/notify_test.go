@@ -0,0 +1,288 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+func testDay() common.FocusData {
+	return common.FocusData{Date: "2025-05-06", TotalFocus: 42, Categories: map[string]int{"학습": 12, "업무": 30, "운동": 0}}
+}
+
+const testEval = "업무: 기울기 1.20 (상승)  학습: 기울기 -0.50 (하락)  "
+
+func testMessage(t *testing.T, tr i18n.Printer) Message {
+	t.Helper()
+	tmpl, err := ParseTemplate("", tr)
+	if err != nil {
+		t.Fatalf("ParseTemplate 실패: %v", err)
+	}
+	msg, err := NewMessage(NewSummary(testDay(), testEval, tr), tmpl, tr)
+	if err != nil {
+		t.Fatalf("NewMessage 실패: %v", err)
+	}
+	msg.Image = &Attachment{Name: "2025-05-06.png", ContentType: "image/png", Data: []byte("\x89PNG\x00data")}
+	return msg
+}
+
+func TestNewSummary(t *testing.T) {
+	s := NewSummary(testDay(), testEval, i18n.New(i18n.English))
+	if s.DateLabel != "May 6, 2025" || s.TotalFocus != 42 {
+		t.Errorf("요약 머리 이상: %+v", s)
+	}
+	if len(s.Categories) != 3 || s.Categories[0].Label != "Work" || s.Categories[1].Name != "학습" || s.Categories[2].Score != 0 {
+		t.Errorf("카테고리 정렬/이름 이상: %+v", s.Categories)
+	}
+	if len(s.Eval) != 2 || s.Eval[1] != "학습: 기울기 -0.50 (하락)" {
+		t.Errorf("트렌드 평가 분리 이상: %q", s.Eval)
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	msg := testMessage(t, i18n.New(i18n.Korean))
+	if msg.Title != "2025년 5월 6일 몰입 요약" {
+		t.Errorf("제목 = %q", msg.Title)
+	}
+	for _, want := range []string{"총 몰입 점수 42", "• 업무: 30\n• 학습: 12", "최근 트렌드\n• 업무: 기울기 1.20 (상승)"} {
+		if !strings.Contains(msg.Text, want) {
+			t.Errorf("본문에 %q 없음:\n%s", want, msg.Text)
+		}
+	}
+	if en := testMessage(t, i18n.New(i18n.English)); !strings.HasPrefix(en.Text, "Total focus score 42\n• Work: 30") {
+		t.Errorf("영어 본문 이상:\n%s", en.Text)
+	}
+
+	path := filepath.Join(t.TempDir(), "notify.tmpl")
+	os.WriteFile(path, []byte("{{.Date}}={{.TotalFocus}}{{range .Categories}} {{.Name}}{{end}}"), 0o644)
+	tr := i18n.New(i18n.Korean)
+	tmpl, err := ParseTemplate(path, tr)
+	if err != nil {
+		t.Fatalf("템플릿 파일 파싱 실패: %v", err)
+	}
+	msg, err = NewMessage(NewSummary(testDay(), "", tr), tmpl, tr)
+	if err != nil || msg.Text != "2025-05-06=42 업무 학습 운동" {
+		t.Errorf("템플릿 파일 본문 = %q, %v", msg.Text, err)
+	}
+	os.WriteFile(path, []byte("{{.Missing"), 0o644)
+	if _, err := ParseTemplate(path, tr); err == nil {
+		t.Errorf("잘못된 템플릿에 에러가 없음")
+	}
+}
+
+// captureServer: 받은 JSON 본문을 기록하는 stub 서버
+func captureServer(t *testing.T, status int) (*httptest.Server, *[]map[string]interface{}) {
+	t.Helper()
+	var got []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("JSON 디코딩 실패: %v", err)
+		}
+		got = append(got, body)
+		w.WriteHeader(status)
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &got
+}
+
+func TestWebhook(t *testing.T) {
+	srv, got := captureServer(t, http.StatusOK)
+	msg := testMessage(t, i18n.New(i18n.Korean))
+	if err := NewWebhook(srv.URL).Notify(context.Background(), msg); err != nil {
+		t.Fatalf("Notify 실패: %v", err)
+	}
+	body := (*got)[0]
+	summary := body["summary"].(map[string]interface{})
+	if body["title"] != msg.Title || summary["totalFocus"] != float64(42) || len(summary["eval"].([]interface{})) != 2 {
+		t.Errorf("webhook 본문 이상: %v", body)
+	}
+	image := body["image"].(map[string]interface{})
+	data, _ := base64.StdEncoding.DecodeString(image["data"].(string))
+	if image["name"] != "2025-05-06.png" || string(data) != "\x89PNG\x00data" {
+		t.Errorf("첨부 이상: %v", image)
+	}
+
+	failing, _ := captureServer(t, http.StatusInternalServerError)
+	if err := NewWebhook(failing.URL).Notify(context.Background(), msg); err == nil || !strings.Contains(err.Error(), "HTTP 500") {
+		t.Errorf("500 응답 에러 = %v", err)
+	}
+}
+
+func TestSlack(t *testing.T) {
+	srv, got := captureServer(t, http.StatusOK)
+	msg := testMessage(t, i18n.New(i18n.Korean))
+	s := NewSlack(srv.URL)
+	if err := s.Notify(context.Background(), msg); err != nil {
+		t.Fatalf("Notify 실패: %v", err)
+	}
+	msg.ImageURL = "https://example.com/graph.png"
+	if err := s.Notify(context.Background(), msg); err != nil {
+		t.Fatalf("Notify 실패: %v", err)
+	}
+	first, second := (*got)[0]["blocks"].([]interface{}), (*got)[1]["blocks"].([]interface{})
+	if len(first) != 2 || len(second) != 3 {
+		t.Fatalf("블록 수 = %d, %d", len(first), len(second))
+	}
+	if img := second[2].(map[string]interface{}); img["type"] != "image" || img["image_url"] != msg.ImageURL {
+		t.Errorf("이미지 블록 이상: %v", img)
+	}
+	if !strings.HasPrefix((*got)[0]["text"].(string), msg.Title+"\n총 몰입 점수") {
+		t.Errorf("대체 텍스트 이상: %v", (*got)[0]["text"])
+	}
+}
+
+// smtpStub: 인증 없는 최소 SMTP 서버 (DATA 본문 하나를 받아 채널로 전달)
+func smtpStub(t *testing.T) (host string, port int, data <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	ch := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		reply("220 stub ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 stub")
+			case cmd == "DATA":
+				reply("354 end with .")
+				var b strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					b.WriteString(l)
+				}
+				ch <- b.String()
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	addr := ln.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, ch
+}
+
+func TestEmail(t *testing.T) {
+	host, port, data := smtpStub(t)
+	msg := testMessage(t, i18n.New(i18n.Korean))
+	e := NewEmail(SMTPConfig{Host: host, Port: port, From: "focus@example.com", To: []string{"me@example.com", "you@example.com"}})
+	if err := e.Notify(context.Background(), msg); err != nil {
+		t.Fatalf("Notify 실패: %v", err)
+	}
+
+	m, err := mail.ReadMessage(strings.NewReader(<-data))
+	if err != nil {
+		t.Fatalf("메일 파싱 실패: %v", err)
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if subject != msg.Title || m.Header.Get("To") != "me@example.com, you@example.com" {
+		t.Errorf("메일 헤더 이상: %q %q", subject, m.Header.Get("To"))
+	}
+	_, params, _ := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	var parts []string
+	var filename string
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("파트 읽기 실패: %v", err)
+		}
+		b, _ := io.ReadAll(base64.NewDecoder(base64.StdEncoding, p))
+		parts = append(parts, string(b))
+		if p.FileName() != "" {
+			filename = p.FileName()
+		}
+	}
+	if len(parts) != 2 || parts[0] != msg.Text || parts[1] != "\x89PNG\x00data" || filename != "2025-05-06.png" {
+		t.Errorf("메일 본문/첨부 이상: %q (%s)", parts, filename)
+	}
+}
+
+func TestEmailContext(t *testing.T) {
+	// 연결만 받고 인사말을 보내지 않는 서버: ctx 마감으로 끝나야 함
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	addr := ln.Addr().(*net.TCPAddr)
+	e := NewEmail(SMTPConfig{Host: addr.IP.String(), Port: addr.Port, From: "focus@example.com", To: []string{"me@example.com"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = e.Notify(ctx, testMessage(t, i18n.New(i18n.Korean)))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("마감 지난 ctx 에러 = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("ctx 마감 후에도 %s 동안 대기", elapsed)
+	}
+}
+
+func TestNewAndSend(t *testing.T) {
+	ok, got := captureServer(t, http.StatusOK)
+	failing, _ := captureServer(t, http.StatusBadRequest)
+	notifiers := New(Config{WebhookURL: failing.URL, SlackWebhookURL: ok.URL, SMTP: SMTPConfig{Host: "localhost"}})
+	if len(notifiers) != 2 || notifiers[0].Name() != "webhook" || notifiers[1].Name() != "slack" {
+		t.Fatalf("백엔드 구성 이상: %v", notifiers)
+	}
+	if e := New(Config{SMTP: SMTPConfig{Host: "smtp.example.com", To: []string{"me@example.com"}}}); len(e) != 1 || e[0].(*Email).Config.Port != 587 {
+		t.Errorf("메일 백엔드 기본 포트 이상: %v", e)
+	}
+
+	err := Send(context.Background(), notifiers, testMessage(t, i18n.New(i18n.Korean)))
+	if err == nil || !strings.Contains(err.Error(), "[webhook]") || strings.Contains(err.Error(), "[slack]") {
+		t.Errorf("Send 에러 = %v", err)
+	}
+	if len(*got) != 1 {
+		t.Errorf("실패한 백엔드 뒤의 백엔드가 호출되지 않음")
+	}
+}
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Slack: Slack 호환 incoming webhook 백엔드
+// - incoming webhook은 파일 업로드를 못 하므로 그래프는 ImageURL이 있을 때만 이미지 블록으로 붙임
+type Slack struct {
+	WebhookURL string
+	HTTP       *http.Client
+}
+
+// NewSlack: Slack 백엔드 생성
+func NewSlack(webhookURL string) *Slack {
+	return &Slack{WebhookURL: webhookURL, HTTP: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (s *Slack) Name() string { return "slack" }
+
+// slackBlock: Block Kit 블록 중 사용하는 필드
+type slackBlock struct {
+	Type     string     `json:"type"`
+	Text     *slackText `json:"text,omitempty"`
+	ImageURL string     `json:"image_url,omitempty"`
+	AltText  string     `json:"alt_text,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func (s *Slack) Notify(ctx context.Context, msg Message) error {
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: msg.Title}},
+		{Type: "section", Text: &slackText{Type: "mrkdwn", Text: msg.Text}},
+	}
+	if msg.ImageURL != "" {
+		blocks = append(blocks, slackBlock{Type: "image", ImageURL: msg.ImageURL, AltText: msg.Title})
+	}
+	payload := map[string]interface{}{
+		"text":   msg.Title + "\n" + msg.Text,
+		"blocks": blocks,
+	}
+	return postJSON(ctx, s.HTTP, s.WebhookURL, payload)
+}
@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// postJSON: payload를 JSON으로 POST (2xx가 아니면 응답 본문과 함께 에러)
+func postJSON(ctx context.Context, client *http.Client, url string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("알림 인코딩 실패: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("알림 요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("알림 전송 실패: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("알림 전송 실패: HTTP %d %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// Webhook: 일반 webhook 백엔드 (요약 전체와 첨부를 JSON으로 POST, 첨부 data는 base64)
+type Webhook struct {
+	URL  string
+	HTTP *http.Client
+}
+
+// NewWebhook: webhook 백엔드 생성
+func NewWebhook(url string) *Webhook {
+	return &Webhook{URL: url, HTTP: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (w *Webhook) Name() string { return "webhook" }
+
+// webhookPayload: 일반 webhook 본문
+type webhookPayload struct {
+	Title    string      `json:"title"`
+	Text     string      `json:"text"`
+	Summary  Summary     `json:"summary"`
+	ImageURL string      `json:"imageUrl,omitempty"`
+	Image    *Attachment `json:"image,omitempty"`
+}
+
+func (w *Webhook) Notify(ctx context.Context, msg Message) error {
+	return postJSON(ctx, w.HTTP, w.URL, webhookPayload{
+		Title:    msg.Title,
+		Text:     msg.Text,
+		Summary:  msg.Summary,
+		ImageURL: msg.ImageURL,
+		Image:    msg.Image,
+	})
+}
@@ -0,0 +1,213 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+// FeedFile: 일간 리포트 Atom 피드 (저장소 기준)
+var FeedFile = filepath.Join(Dir, "feed.xml")
+
+// DefaultFeedEntries: 피드에 남길 기본 항목 수
+const DefaultFeedEntries = 30
+
+// 피드/항목 ID (링크 주소가 바뀌어도 같은 날짜는 같은 항목으로 취급)
+const (
+	feedID      = "urn:focus-time-tracker:reports"
+	entryPrefix = "urn:focus-time-tracker:report:daily:"
+)
+
+// FeedOptions: Atom 피드 옵션
+// - MaxEntries: 남길 최신 항목 수 (0 이하면 DefaultFeedEntries)
+// - BaseURL: 피드 링크의 기준 주소 (reports/ 디렉토리를 가리키는 공개 주소, 비면 피드 기준 상대 경로)
+type FeedOptions struct {
+	MaxEntries int
+	BaseURL    string
+}
+
+// maxEntries: 기본값을 적용한 항목 수
+func (o FeedOptions) maxEntries() int {
+	if o.MaxEntries <= 0 {
+		return DefaultFeedEntries
+	}
+	return o.MaxEntries
+}
+
+// atomFeed: Atom 1.0 피드 중 사용하는 요소 (기존 피드를 읽을 때도 씀)
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Base    string      `xml:"http://www.w3.org/XML/1998/namespace base,attr,omitempty"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Links   []atomLink `xml:"link"`
+	Summary string     `xml:"summary"`
+	Content atomText   `xml:"content"`
+}
+
+type atomLink struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+// UpdateFeed: 마지막 날짜(sorted 끝)의 항목을 reports/feed.xml에 넣거나 교체하고 최신 MaxEntries개만 남김
+// - sorted: 해당 날짜까지의 일자 오름차순 데이터 (최근 TrendDays일을 트렌드 평가에 사용)
+// - 기존 피드의 다른 날짜 항목은 그대로 둠 (피드가 없으면 새로 만듦)
+// 반환: 저장소 기준 피드 경로, 에러
+func UpdateFeed(sorted []common.FocusData, opts Options) (string, error) {
+	path := filepath.Join(opts.RepoPath, FeedFile)
+	feed, err := loadFeed(path)
+	if err != nil {
+		return "", err
+	}
+	tr := opts.printer()
+	now := opts.Render.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	entry := feedEntry(sorted, now, opts)
+	entries := []atomEntry{entry}
+	for _, e := range feed.Entries {
+		if e.ID != entry.ID {
+			entries = append(entries, e)
+		}
+	}
+	// ID가 날짜로 끝나므로 문자열 역순 = 최신 날짜 먼저
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].ID > entries[j].ID })
+	if max := opts.Feed.maxEntries(); len(entries) > max {
+		entries = entries[:max]
+	}
+
+	feed.ID = feedID
+	feed.Title = tr.T("report.feed.title")
+	feed.Base = opts.Feed.BaseURL
+	feed.Links = []atomLink{
+		{Rel: "self", Type: "application/atom+xml", Href: "feed.xml"},
+		{Rel: "alternate", Href: "README.md"},
+	}
+	feed.Entries = entries
+	feed.Updated = entry.Updated
+	for _, e := range entries {
+		if e.Updated > feed.Updated {
+			feed.Updated = e.Updated
+		}
+	}
+
+	b, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("피드 인코딩 실패: %w", err)
+	}
+	if err := writeFile(path, append([]byte(xml.Header), append(b, '\n')...)); err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(FeedFile), nil
+}
+
+// loadFeed: 기존 피드 읽기 (없으면 빈 피드)
+func loadFeed(path string) (*atomFeed, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &atomFeed{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("피드 읽기 실패: %w", err)
+	}
+	feed := &atomFeed{}
+	if err := xml.Unmarshal(b, feed); err != nil {
+		return nil, fmt.Errorf("피드 파싱 실패 (%s): %w", path, err)
+	}
+	return feed, nil
+}
+
+// feedEntry: 일간 리포트 하나의 피드 항목 (요약 통계, 트렌드 평가, 차트 링크)
+// - 링크는 피드(reports/) 기준 상대 경로 (BaseURL이 있으면 xml:base로 풀림)
+func feedEntry(sorted []common.FocusData, now time.Time, opts Options) atomEntry {
+	tr := opts.printer()
+	d := sorted[len(sorted)-1]
+	day, _ := time.Parse("2006-01-02", d.Date)
+	recent := recentDays(sorted)
+	render := opts.Render
+	render.Now = day
+
+	e := atomEntry{
+		ID:      entryPrefix + d.Date,
+		Title:   tr.T("report.daily.title", tr.Date(day), tr.Weekday(day.Weekday())),
+		Updated: now.Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "alternate", Type: "text/markdown", Href: "daily/" + d.Date + ".md"}},
+		Summary: tr.T("report.feed.summary", d.TotalFocus),
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<p>%s</p>\n", html.EscapeString(e.Summary))
+	writeFeedStats(&b, d, tr)
+	fmt.Fprintf(&b, "<h3>%s</h3>\n<ul>\n", html.EscapeString(tr.T("report.eval")))
+	for _, item := range evalItems(recent, render) {
+		fmt.Fprintf(&b, "<li>%s</li>\n", html.EscapeString(item))
+	}
+	b.WriteString("</ul>\n")
+	fmt.Fprintf(&b, "<h3>%s</h3>\n", html.EscapeString(tr.T("report.charts")))
+	for _, c := range dailyCharts(d, recent, render, tr) {
+		rel, _ := filepath.Rel(Dir, chartPath(c.name, opts))
+		href := filepath.ToSlash(rel)
+		e.Links = append(e.Links, atomLink{Rel: "enclosure", Type: imageType(href), Title: c.title, Href: href})
+		switch strings.ToLower(filepath.Ext(href)) {
+		case ".png", ".svg":
+			fmt.Fprintf(&b, "<p><img src=\"%s\" alt=\"%s\"/></p>\n", html.EscapeString(href), html.EscapeString(c.title))
+		default:
+			fmt.Fprintf(&b, "<p><a href=\"%s\">%s</a></p>\n", html.EscapeString(href), html.EscapeString(c.title))
+		}
+	}
+	e.Content = atomText{Type: "html", Body: b.String()}
+	return e
+}
+
+// writeFeedStats: 카테고리별 점수 합/최대 점수/효율 HTML 표 (writeStats와 같은 내용)
+func writeFeedStats(b *strings.Builder, d common.FocusData, tr i18n.Printer) {
+	fmt.Fprintf(b, "<h3>%s</h3>\n", html.EscapeString(tr.T("report.stats")))
+	rows := Stats([]common.FocusData{d})
+	if len(rows) == 0 {
+		fmt.Fprintf(b, "<p>%s</p>\n", html.EscapeString(tr.T("report.noStats")))
+		return
+	}
+	fmt.Fprintf(b, "<table>\n<tr><th>%s</th><th>%s</th><th>%s</th><th>%s</th></tr>\n",
+		html.EscapeString(tr.T("term.category")), html.EscapeString(tr.T("report.col.sum")),
+		html.EscapeString(tr.T("report.col.max")), html.EscapeString(tr.T("report.col.eff")))
+	for _, r := range rows {
+		fmt.Fprintf(b, "<tr><td>%s</td><td>%d</td><td>%d</td><td>%.1f%%</td></tr>\n", html.EscapeString(tr.Category(r.Category)), r.Sum, r.MaxScore, r.Efficiency)
+	}
+	b.WriteString("</table>\n")
+}
+
+// imageType: 차트 파일의 MIME 타입 (모르는 확장자는 application/octet-stream)
+func imageType(path string) string {
+	if t := mime.TypeByExtension(strings.ToLower(filepath.Ext(path))); t != "" {
+		return strings.SplitN(t, ";", 2)[0]
+	}
+	return "application/octet-stream"
+}
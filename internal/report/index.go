@@ -0,0 +1,165 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+// SUMMARY.md에서 리포트 목차를 관리하는 구간 (구간 밖 내용은 그대로 둠)
+const (
+	SummaryFile  = "SUMMARY.md"
+	summaryStart = "<!-- focus-report:start -->"
+	summaryEnd   = "<!-- focus-report:end -->"
+)
+
+// IndexFile: 리포트 인덱스 페이지 (저장소 기준)
+var IndexFile = filepath.Join(Dir, "README.md")
+
+// weekEntry: 목차의 한 주 (주간 페이지 유무 + 그 주의 일간 페이지 날짜, 최신순)
+type weekEntry struct {
+	id     string
+	weekly bool
+	days   []string
+}
+
+// UpdateIndex: 저장소에 있는 일간/주간 페이지로 reports/README.md와 SUMMARY.md 목차 구간을 다시 씀
+// - repoPath: GitBook 저장소 경로
+// - tr: 목차 문구 언어
+// 반환: 저장소 기준 수정된 파일 경로 목록, 에러
+func UpdateIndex(repoPath string, tr i18n.Printer) ([]string, error) {
+	weeks, err := scanPages(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := writePage(repoPath, IndexFile, indexPage(weeks, tr)); err != nil {
+		return nil, err
+	}
+	if err := updateSummary(filepath.Join(repoPath, SummaryFile), summaryBlock(weeks, tr)); err != nil {
+		return nil, err
+	}
+	return []string{filepath.ToSlash(IndexFile), SummaryFile}, nil
+}
+
+// scanPages: reports/daily, reports/weekly의 페이지를 주 단위로 묶기 (최신 주/날짜 먼저)
+func scanPages(repoPath string) ([]weekEntry, error) {
+	byWeek := map[string]*weekEntry{}
+	entry := func(id string) *weekEntry {
+		if byWeek[id] == nil {
+			byWeek[id] = &weekEntry{id: id}
+		}
+		return byWeek[id]
+	}
+	weekly, err := filepath.Glob(filepath.Join(repoPath, WeeklyDir, "*.md"))
+	if err != nil {
+		return nil, fmt.Errorf("주간 리포트 검색 실패: %w", err)
+	}
+	for _, f := range weekly {
+		entry(strings.TrimSuffix(filepath.Base(f), ".md")).weekly = true
+	}
+	daily, err := filepath.Glob(filepath.Join(repoPath, DailyDir, "*.md"))
+	if err != nil {
+		return nil, fmt.Errorf("일간 리포트 검색 실패: %w", err)
+	}
+	for _, f := range daily {
+		date := strings.TrimSuffix(filepath.Base(f), ".md")
+		t, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			continue
+		}
+		e := entry(WeekID(t))
+		e.days = append(e.days, date)
+	}
+
+	weeks := make([]weekEntry, 0, len(byWeek))
+	for _, e := range byWeek {
+		sort.Sort(sort.Reverse(sort.StringSlice(e.days)))
+		weeks = append(weeks, *e)
+	}
+	sort.Slice(weeks, func(i, j int) bool { return weeks[i].id > weeks[j].id })
+	return weeks, nil
+}
+
+// weekTitle: "2025-W19" → 목차용 주 이름 (형식이 다르면 ID 그대로)
+func weekTitle(id string, tr i18n.Printer) string {
+	var year, week int
+	if _, err := fmt.Sscanf(id, "%d-W%d", &year, &week); err != nil {
+		return id
+	}
+	return tr.T("report.weekly.title", year, week)
+}
+
+// dayTitle: "2006-01-02" → 목차용 날짜 이름
+func dayTitle(date string, tr i18n.Printer) string {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return tr.ShortDate(t)
+	}
+	return date
+}
+
+// indexPage: reports/README.md 내용 (주간 목록, 그 아래 일간 목록)
+func indexPage(weeks []weekEntry, tr i18n.Printer) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", tr.T("report.index.title"))
+	if len(weeks) == 0 {
+		fmt.Fprintf(&b, "%s\n", tr.T("report.index.empty"))
+		return b.String()
+	}
+	for _, w := range weeks {
+		if w.weekly {
+			fmt.Fprintf(&b, "## [%s](weekly/%s.md)\n\n", weekTitle(w.id, tr), w.id)
+		} else {
+			fmt.Fprintf(&b, "## %s\n\n", weekTitle(w.id, tr))
+		}
+		for _, d := range w.days {
+			fmt.Fprintf(&b, "- [%s](daily/%s.md)\n", dayTitle(d, tr), d)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// summaryBlock: SUMMARY.md 마커 구간에 들어갈 목차 (마커 포함)
+func summaryBlock(weeks []weekEntry, tr i18n.Printer) string {
+	var b strings.Builder
+	b.WriteString(summaryStart + "\n")
+	fmt.Fprintf(&b, "* [%s](%s)\n", tr.T("report.index.title"), filepath.ToSlash(IndexFile))
+	for _, w := range weeks {
+		indent := "  "
+		if w.weekly {
+			fmt.Fprintf(&b, "  * [%s](%s/%s.md)\n", weekTitle(w.id, tr), WeeklyDir, w.id)
+			indent = "    "
+		}
+		for _, d := range w.days {
+			fmt.Fprintf(&b, "%s* [%s](%s/%s.md)\n", indent, dayTitle(d, tr), DailyDir, d)
+		}
+	}
+	b.WriteString(summaryEnd + "\n")
+	return b.String()
+}
+
+// updateSummary: SUMMARY.md의 마커 구간을 block으로 교체 (파일/마커가 없으면 끝에 추가)
+func updateSummary(path, block string) error {
+	b, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("SUMMARY.md 읽기 실패: %w", err)
+	}
+	content := string(b)
+	if content == "" {
+		content = "# Table of contents\n\n"
+	}
+	start := strings.Index(content, summaryStart)
+	end := strings.Index(content, summaryEnd)
+	if start >= 0 && end > start {
+		rest := content[end+len(summaryEnd):]
+		content = content[:start] + block + strings.TrimPrefix(rest, "\n")
+	} else {
+		content = strings.TrimRight(content, "\n") + "\n\n" + block
+	}
+	return writeFile(path, []byte(content))
+}
@@ -0,0 +1,387 @@
+// Package report: GitBook 저장소에 올릴 일간/주간 Markdown 리포트 페이지 생성
+// - 페이지: reports/daily/2006-01-02.md, reports/weekly/2006-W01.md
+// - 차트 이미지: <assets>/reports/ (페이지에서 상대 경로로 임베드)
+// - 목차: reports/README.md 인덱스 + SUMMARY.md의 마커 구간 (index.go)
+// - 피드: reports/feed.xml Atom 피드, 일간 리포트 하나당 항목 하나 (feed.go)
+package report
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+// 저장소 루트 기준 리포트 경로
+const (
+	Dir       = "reports"
+	DailyDir  = "reports/daily"
+	WeeklyDir = "reports/weekly"
+)
+
+// TrendDays: 일간 리포트 트렌드 차트/평가에 쓰는 최근 일수 (해당 날짜 포함)
+const TrendDays = 7
+
+// Options: 리포트 생성 옵션
+// - RepoPath: GitBook 저장소 경로
+// - AssetsDir: 저장소 내 이미지 경로 (예: ".gitbook/assets", 리포트 차트는 그 아래 reports/)
+// - Render: 차트 포맷/크기/테마/언어 (Now는 페이지별 날짜로 덮어씀)
+// - Feed: Atom 피드 항목 수/링크 기준 주소
+type Options struct {
+	RepoPath  string
+	AssetsDir string
+	Render    analyzer.RenderOptions
+	Feed      FeedOptions
+}
+
+// printer: 리포트 언어
+func (o Options) printer() i18n.Printer {
+	return i18n.New(o.Render.Locale)
+}
+
+// WeekID: 날짜의 ISO 주 ID (예: "2025-W19")
+func WeekID(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// Generate: date의 일간 페이지와 그 주의 주간 페이지를 (다시) 쓰고 인덱스/SUMMARY.md/피드 갱신
+// - data: 전체 FocusData 배열 (순서 무관, date 이후 데이터는 무시)
+// - date: 리포트 날짜 ("2006-01-02", data에 있어야 함)
+// 반환: 저장소 기준 생성/수정된 파일 경로 목록, 에러
+func Generate(data []common.FocusData, date string, opts Options) ([]string, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, fmt.Errorf("리포트 날짜 형식 오류: %w", err)
+	}
+	sorted := sortedUntil(data, date)
+	if len(sorted) == 0 || sorted[len(sorted)-1].Date != date {
+		return nil, fmt.Errorf("%s 데이터가 없습니다", date)
+	}
+
+	written := []string{}
+	daily, err := writeDaily(sorted, opts)
+	if err != nil {
+		return nil, err
+	}
+	written = append(written, daily...)
+
+	weekly, err := writeWeekly(weekData(sorted, day), day, opts)
+	if err != nil {
+		return nil, err
+	}
+	written = append(written, weekly...)
+
+	index, err := UpdateIndex(opts.RepoPath, opts.printer())
+	if err != nil {
+		return nil, err
+	}
+	written = append(written, index...)
+
+	feed, err := UpdateFeed(sorted, opts)
+	if err != nil {
+		return nil, err
+	}
+	return append(written, feed), nil
+}
+
+// sortedUntil: date 이전(포함) 데이터를 일자 오름차순으로 복사
+func sortedUntil(data []common.FocusData, date string) []common.FocusData {
+	out := []common.FocusData{}
+	for _, d := range data {
+		if d.Date <= date {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Date < out[j].Date })
+	return out
+}
+
+// recentDays: 일자 오름차순 데이터의 최근 TrendDays일
+func recentDays(sorted []common.FocusData) []common.FocusData {
+	if len(sorted) > TrendDays {
+		return sorted[len(sorted)-TrendDays:]
+	}
+	return sorted
+}
+
+// weekData: day와 같은 ISO 주의 데이터 (sorted는 일자 오름차순)
+func weekData(sorted []common.FocusData, day time.Time) []common.FocusData {
+	week := WeekID(day)
+	out := []common.FocusData{}
+	for _, d := range sorted {
+		if t, err := time.Parse("2006-01-02", d.Date); err == nil && WeekID(t) == week {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// writeDaily: 마지막 날짜(sorted 끝)의 일간 페이지와 차트 저장
+// - sorted: 해당 날짜까지의 일자 오름차순 데이터 (최근 TrendDays일을 트렌드에 사용)
+func writeDaily(sorted []common.FocusData, opts Options) ([]string, error) {
+	tr := opts.printer()
+	d := sorted[len(sorted)-1]
+	day, _ := time.Parse("2006-01-02", d.Date)
+	recent := recentDays(sorted)
+	render := opts.Render
+	render.Now = day
+
+	pagePath := filepath.Join(DailyDir, d.Date+".md")
+	images, written, err := saveCharts(dailyCharts(d, recent, render, tr), pagePath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", tr.T("report.daily.title", tr.Date(day), tr.Weekday(day.Weekday())))
+	fmt.Fprintf(&b, "%s\n\n", tr.T("report.total", d.TotalFocus))
+	writeCharts(&b, images, tr)
+	writeStats(&b, []common.FocusData{d}, tr)
+	writeEval(&b, recent, render, tr)
+	if err := writePage(opts.RepoPath, pagePath, b.String()); err != nil {
+		return nil, err
+	}
+	return append(written, filepath.ToSlash(pagePath)), nil
+}
+
+// dailyCharts: 일간 페이지 차트 (피드 항목도 같은 이름/제목을 씀)
+// - recent: 트렌드 차트에 쓸 최근 데이터 (d 포함)
+func dailyCharts(d common.FocusData, recent []common.FocusData, render analyzer.RenderOptions, tr i18n.Printer) []chart {
+	return []chart{
+		{name: d.Date + "-trends", title: tr.T("report.chart.trends", TrendDays), plot: func() ([]byte, error) {
+			return analyzer.PlotFocusTrendsAndRegression(recent, render)
+		}},
+		{name: d.Date + "-timeslot", title: tr.T("timeslot.title"), plot: func() ([]byte, error) {
+			return analyzer.PlotTimeSlotAverageFocus([]common.FocusData{d}, render)
+		}},
+	}
+}
+
+// writeWeekly: 한 주 데이터의 주간 페이지와 차트 저장
+// - week: 같은 ISO 주의 일자 오름차순 데이터 (1일 이상)
+// - day: 주에 속한 아무 날짜 (주 범위 계산용)
+func writeWeekly(week []common.FocusData, day time.Time, opts Options) ([]string, error) {
+	tr := opts.printer()
+	id := WeekID(day)
+	monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	sunday := monday.AddDate(0, 0, 6)
+	last, _ := time.Parse("2006-01-02", week[len(week)-1].Date)
+	render := opts.Render
+	render.Now = last
+
+	pagePath := filepath.Join(WeeklyDir, id+".md")
+	charts := []chart{
+		{name: id + "-trends", title: tr.T("trends.title"), plot: func() ([]byte, error) {
+			return analyzer.PlotFocusTrendsAndRegression(week, render)
+		}},
+		{name: id + "-share", title: tr.T("share.ratio"), plot: func() ([]byte, error) {
+			return analyzer.PlotCategoryShare(week, true, render)
+		}},
+	}
+	images, written, err := saveCharts(charts, pagePath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, d := range week {
+		total += d.TotalFocus
+	}
+	year, num := day.ISOWeek()
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", tr.T("report.weekly.title", year, num))
+	fmt.Fprintf(&b, "%s\n\n", tr.T("report.period", tr.DateRange(monday.Format("2006-01-02"), sunday.Format("2006-01-02")), len(week)))
+	fmt.Fprintf(&b, "%s\n\n", tr.T("report.total", total))
+	writeCharts(&b, images, tr)
+	writeStats(&b, week, tr)
+
+	fmt.Fprintf(&b, "## %s\n\n", tr.T("report.days"))
+	fmt.Fprintf(&b, "| %s | %s |\n| --- | ---: |\n", tr.T("axis.date"), tr.T("report.col.total"))
+	for _, d := range week {
+		label := d.Date
+		if t, err := time.Parse("2006-01-02", d.Date); err == nil {
+			label = tr.ShortDate(t)
+		}
+		fmt.Fprintf(&b, "| [%s](../daily/%s.md) | %d |\n", label, d.Date, d.TotalFocus)
+	}
+	b.WriteString("\n")
+	writeEval(&b, week, render, tr)
+	if err := writePage(opts.RepoPath, pagePath, b.String()); err != nil {
+		return nil, err
+	}
+	return append(written, filepath.ToSlash(pagePath)), nil
+}
+
+// chart: 페이지에 넣을 차트 (name은 확장자 없는 파일 이름)
+type chart struct {
+	name, title string
+	plot        func() ([]byte, error)
+}
+
+// image: 저장된 차트의 제목과 페이지 기준 상대 경로
+type image struct {
+	title, rel string
+}
+
+// saveCharts: 차트를 <assets>/reports/에 저장
+// - pagePath: 저장소 기준 페이지 경로 (이미지 상대 경로 계산용)
+// 반환: 페이지용 이미지 목록, 저장소 기준 저장 경로 목록, 에러
+func saveCharts(charts []chart, pagePath string, opts Options) ([]image, []string, error) {
+	images := []image{}
+	written := []string{}
+	for _, c := range charts {
+		img, err := c.plot()
+		if err != nil {
+			return nil, nil, fmt.Errorf("리포트 차트 %s 생성 실패: %w", c.name, err)
+		}
+		rel := chartPath(c.name, opts)
+		if err := writeFile(filepath.Join(opts.RepoPath, rel), img); err != nil {
+			return nil, nil, err
+		}
+		fromPage, err := filepath.Rel(filepath.Dir(pagePath), rel)
+		if err != nil {
+			return nil, nil, fmt.Errorf("차트 상대 경로 계산 실패: %w", err)
+		}
+		images = append(images, image{title: c.title, rel: filepath.ToSlash(fromPage)})
+		written = append(written, filepath.ToSlash(rel))
+	}
+	return images, written, nil
+}
+
+// chartPath: 저장소 기준 차트 파일 경로
+func chartPath(name string, opts Options) string {
+	return filepath.Join(opts.AssetsDir, Dir, name+opts.Render.Format.Ext())
+}
+
+// writeCharts: 차트 섹션 (PNG/SVG는 이미지로 임베드, PDF/EPS는 링크)
+func writeCharts(b *strings.Builder, images []image, tr i18n.Printer) {
+	fmt.Fprintf(b, "## %s\n\n", tr.T("report.charts"))
+	for _, img := range images {
+		switch strings.ToLower(filepath.Ext(img.rel)) {
+		case ".png", ".svg":
+			fmt.Fprintf(b, "![%s](%s)\n\n", img.title, img.rel)
+		default:
+			fmt.Fprintf(b, "[%s](%s)\n\n", img.title, img.rel)
+		}
+	}
+}
+
+// writeStats: 카테고리별 점수 합/최대 점수/효율 표 (기간 합산, 기록 없는 카테고리 제외)
+func writeStats(b *strings.Builder, data []common.FocusData, tr i18n.Printer) {
+	fmt.Fprintf(b, "## %s\n\n", tr.T("report.stats"))
+	rows := Stats(data)
+	if len(rows) == 0 {
+		fmt.Fprintf(b, "%s\n\n", tr.T("report.noStats"))
+		return
+	}
+	fmt.Fprintf(b, "| %s | %s | %s | %s |\n| --- | ---: | ---: | ---: |\n",
+		tr.T("term.category"), tr.T("report.col.sum"), tr.T("report.col.max"), tr.T("report.col.eff"))
+	sum, max := 0, 0
+	for _, r := range rows {
+		fmt.Fprintf(b, "| %s | %d | %d | %.1f%% |\n", tr.Category(r.Category), r.Sum, r.MaxScore, r.Efficiency)
+		sum += r.Sum
+		max += r.MaxScore
+	}
+	fmt.Fprintf(b, "| **%s** | **%d** | **%d** | **%.1f%%** |\n\n", tr.T("term.total"), sum, max, float64(sum)/float64(max)*100.0)
+}
+
+// writeEval: 회귀 기울기 트렌드 평가 섹션
+func writeEval(b *strings.Builder, data []common.FocusData, render analyzer.RenderOptions, tr i18n.Printer) {
+	fmt.Fprintf(b, "## %s\n\n", tr.T("report.eval"))
+	fmt.Fprintf(b, "%s\n\n", tr.T("report.evalNote", len(data)))
+	for _, item := range evalItems(data, render) {
+		fmt.Fprintf(b, "- %s\n", item)
+	}
+	b.WriteString("\n")
+}
+
+// evalItems: analyzer.EvalText를 카테고리별 항목으로 나눔
+func evalItems(data []common.FocusData, render analyzer.RenderOptions) []string {
+	items := []string{}
+	for _, item := range strings.Split(strings.TrimSpace(analyzer.EvalText(data, render)), "  ") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// StatRow: 카테고리별 기간 합산 통계
+// - Efficiency: Sum / MaxScore * 100
+type StatRow struct {
+	Category   string
+	Sum        int
+	MaxScore   int
+	Efficiency float64
+}
+
+// Stats: 기간 데이터의 카테고리별 점수 합/최대 점수/효율 (MaxScore 없는 카테고리 제외)
+// - 순서: common.Categories 순서 + 나머지 이름순
+func Stats(data []common.FocusData) []StatRow {
+	sums, maxes := map[string]int{}, map[string]int{}
+	for _, d := range data {
+		for cat, m := range d.MaxScore {
+			if m <= 0 {
+				continue
+			}
+			maxes[cat] += m
+			sums[cat] += d.Categories[cat]
+		}
+	}
+	order := []string{}
+	for _, cat := range common.Categories {
+		if _, ok := maxes[cat]; ok {
+			order = append(order, cat)
+		}
+	}
+	extra := []string{}
+	for cat := range maxes {
+		if !contains(common.Categories, cat) {
+			extra = append(extra, cat)
+		}
+	}
+	sort.Strings(extra)
+	rows := []StatRow{}
+	for _, cat := range append(order, extra...) {
+		rows = append(rows, StatRow{
+			Category:   cat,
+			Sum:        sums[cat],
+			MaxScore:   maxes[cat],
+			Efficiency: float64(sums[cat]) / float64(maxes[cat]) * 100.0,
+		})
+	}
+	return rows
+}
+
+// contains: 문자열 슬라이스 포함 여부
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// writePage: 저장소 기준 경로에 페이지 저장
+func writePage(repoPath, rel, content string) error {
+	return writeFile(filepath.Join(repoPath, rel), []byte(content))
+}
+
+// writeFile: 파일 저장 (디렉토리 자동 생성)
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("파일 저장 실패: %w", err)
+	}
+	return nil
+}
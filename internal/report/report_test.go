@@ -0,0 +1,187 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+func testData() []common.FocusData {
+	return []common.FocusData{
+		{Date: "2025-05-06", TotalFocus: 30, Categories: map[string]int{"업무": 30}, MaxScore: map[string]int{"업무": 60}, TimeSlots: map[string]int{"09:00": 3}},
+		{Date: "2025-05-04", TotalFocus: 10, Categories: map[string]int{"학습": 10}, MaxScore: map[string]int{"학습": 20}, TimeSlots: map[string]int{"10:00": 5}},
+		{Date: "2025-05-05", TotalFocus: 45, Categories: map[string]int{"업무": 40, "새일": 5}, MaxScore: map[string]int{"업무": 50, "새일": 10}, TimeSlots: map[string]int{"09:00": 4}},
+	}
+}
+
+func testOptions(t *testing.T) Options {
+	return Options{
+		RepoPath:  t.TempDir(),
+		AssetsDir: ".gitbook/assets",
+		Render:    analyzer.RenderOptions{Format: analyzer.FormatSVG, Width: 400, Height: 200, NoWatermark: true},
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("파일 읽기 실패: %v", err)
+	}
+	return string(b)
+}
+
+func TestStats(t *testing.T) {
+	rows := Stats(testData())
+	if len(rows) != 3 || rows[0].Category != "업무" || rows[1].Category != "학습" || rows[2].Category != "새일" {
+		t.Fatalf("Stats 순서 이상: %+v", rows)
+	}
+	if rows[0].Sum != 70 || rows[0].MaxScore != 110 {
+		t.Errorf("업무 합산 이상: %+v", rows[0])
+	}
+}
+
+func TestWeekID(t *testing.T) {
+	if got := WeekID(time.Date(2025, 5, 4, 0, 0, 0, 0, time.UTC)); got != "2025-W18" {
+		t.Errorf("일요일 WeekID = %s", got)
+	}
+	if got := WeekID(time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)); got != "2025-W19" {
+		t.Errorf("월요일 WeekID = %s", got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	opts := testOptions(t)
+	summary := filepath.Join(opts.RepoPath, SummaryFile)
+	if err := os.WriteFile(summary, []byte("# Table of contents\n\n* [소개](README.md)\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := Generate(testData(), "2025-05-06", opts)
+	if err != nil {
+		t.Fatalf("Generate 실패: %v", err)
+	}
+	for _, rel := range written {
+		if _, err := os.Stat(filepath.Join(opts.RepoPath, rel)); err != nil {
+			t.Errorf("생성 목록의 파일이 없음: %s", rel)
+		}
+	}
+
+	daily := readFile(t, filepath.Join(opts.RepoPath, DailyDir, "2025-05-06.md"))
+	for _, want := range []string{
+		"# 2025년 5월 6일 (화) 몰입 리포트",
+		"![카테고리별 트렌드 및 회귀선 (최근 7일)](../../.gitbook/assets/reports/2025-05-06-trends.svg)",
+		"| 업무 | 30 | 60 | 50.0% |",
+		"- 업무: ",
+	} {
+		if !strings.Contains(daily, want) {
+			t.Errorf("일간 페이지에 %q 없음:\n%s", want, daily)
+		}
+	}
+
+	// 5/5, 5/6만 같은 주 (5/4는 전 주 일요일)
+	weekly := readFile(t, filepath.Join(opts.RepoPath, WeeklyDir, "2025-W19.md"))
+	for _, want := range []string{"2025년 19주차", "기록 2일", "| 업무 | 70 | 110 | 63.6% |", "(../daily/2025-05-05.md)"} {
+		if !strings.Contains(weekly, want) {
+			t.Errorf("주간 페이지에 %q 없음:\n%s", want, weekly)
+		}
+	}
+	if strings.Contains(weekly, "2025-05-04") {
+		t.Errorf("다른 주 날짜가 주간 페이지에 포함됨")
+	}
+
+	if _, err := Generate(testData(), "2025-05-04", opts); err != nil {
+		t.Fatalf("Generate(5/4) 실패: %v", err)
+	}
+	got := readFile(t, summary)
+	if !strings.HasPrefix(got, "# Table of contents\n\n* [소개](README.md)\n\n"+summaryStart) {
+		t.Errorf("SUMMARY.md 기존 내용이 유지되지 않음:\n%s", got)
+	}
+	if strings.Count(got, summaryStart) != 1 || strings.Index(got, "2025-W19.md") > strings.Index(got, "2025-W18.md") {
+		t.Errorf("SUMMARY.md 목차 이상 (최신 주가 먼저여야 함):\n%s", got)
+	}
+	if !strings.Contains(got, "    * [5/6(화)](reports/daily/2025-05-06.md)") {
+		t.Errorf("일간 페이지가 주 아래에 없음:\n%s", got)
+	}
+	index := readFile(t, filepath.Join(opts.RepoPath, IndexFile))
+	if !strings.Contains(index, "## [2025년 18주차 리포트](weekly/2025-W18.md)") {
+		t.Errorf("인덱스 페이지 이상:\n%s", index)
+	}
+}
+
+func TestGenerate_English(t *testing.T) {
+	opts := testOptions(t)
+	opts.Render.Locale = i18n.English
+	if _, err := Generate(testData(), "2025-05-05", opts); err != nil {
+		t.Fatalf("Generate 실패: %v", err)
+	}
+	daily := readFile(t, filepath.Join(opts.RepoPath, DailyDir, "2025-05-05.md"))
+	if !strings.Contains(daily, "# Focus report for May 5, 2025 (Mon)") || !strings.Contains(daily, "| Work | 40 | 50 | 80.0% |") || !strings.Contains(daily, "| 새일 |") {
+		t.Errorf("영어 일간 페이지 이상:\n%s", daily)
+	}
+}
+
+func TestGenerate_MissingDate(t *testing.T) {
+	if _, err := Generate(testData(), "2025-05-07", testOptions(t)); err == nil {
+		t.Errorf("데이터 없는 날짜에 에러가 없음")
+	}
+	if _, err := Generate(testData(), "05/06", testOptions(t)); err == nil {
+		t.Errorf("잘못된 날짜 형식에 에러가 없음")
+	}
+}
+
+func TestUpdateFeed(t *testing.T) {
+	opts := testOptions(t)
+	opts.Feed = FeedOptions{MaxEntries: 2}
+	for i, date := range []string{"2025-05-04", "2025-05-06", "2025-05-05", "2025-05-06"} {
+		opts.Render.Now = time.Date(2025, 5, 7, 9, i, 0, 0, time.UTC)
+		written, err := Generate(testData(), date, opts)
+		if err != nil {
+			t.Fatalf("Generate(%s) 실패: %v", date, err)
+		}
+		if written[len(written)-1] != "reports/feed.xml" {
+			t.Errorf("생성 목록에 피드 없음: %v", written)
+		}
+	}
+
+	feed, err := loadFeed(filepath.Join(opts.RepoPath, FeedFile))
+	if err != nil {
+		t.Fatalf("피드 읽기 실패: %v", err)
+	}
+	if len(feed.Entries) != 2 || feed.Entries[0].ID != entryPrefix+"2025-05-06" || feed.Entries[1].ID != entryPrefix+"2025-05-05" {
+		t.Fatalf("피드 항목 이상 (최신 2개, 날짜당 하나): %+v", feed.Entries)
+	}
+	if feed.Updated != "2025-05-07T09:03:00Z" || feed.Entries[1].Updated != "2025-05-07T09:02:00Z" {
+		t.Errorf("갱신 시각 이상: %s, %s", feed.Updated, feed.Entries[1].Updated)
+	}
+	e := feed.Entries[0]
+	if e.Title != "2025년 5월 6일 (화) 몰입 리포트" || e.Summary != "총 몰입 점수 30" || e.Content.Type != "html" {
+		t.Errorf("항목 머리 이상: %+v", e)
+	}
+	for _, want := range []string{"<td>업무</td><td>30</td><td>60</td><td>50.0%</td>", "<li>업무: ", `<img src="../.gitbook/assets/reports/2025-05-06-trends.svg"`} {
+		if !strings.Contains(e.Content.Body, want) {
+			t.Errorf("항목 본문에 %q 없음:\n%s", want, e.Content.Body)
+		}
+	}
+	if len(e.Links) != 3 || e.Links[0].Href != "daily/2025-05-06.md" || e.Links[2].Type != "image/svg+xml" || e.Links[2].Href != "../.gitbook/assets/reports/2025-05-06-timeslot.svg" {
+		t.Errorf("항목 링크 이상: %+v", e.Links)
+	}
+
+	opts.Feed.BaseURL = "https://example.com/book/reports/"
+	if _, err := Generate(testData(), "2025-05-06", opts); err != nil {
+		t.Fatalf("Generate 실패: %v", err)
+	}
+	raw := readFile(t, filepath.Join(opts.RepoPath, FeedFile))
+	if !strings.HasPrefix(raw, `<?xml version="1.0" encoding="UTF-8"?>`) || !strings.Contains(raw, `<feed xmlns="http://www.w3.org/2005/Atom" xml:base="https://example.com/book/reports/">`) {
+		t.Errorf("피드 머리 이상:\n%s", raw)
+	}
+	if strings.Count(raw, "<entry>") != 2 {
+		t.Errorf("다시 생성한 날짜가 중복됨:\n%s", raw)
+	}
+}
@@ -0,0 +1,230 @@
+package sheets
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+	"google.golang.org/api/sheets/v4"
+)
+
+// AnalysisSheetTitle: 분석 결과를 다시 쓰는 탭 이름 (extract마다 통째로 교체)
+const AnalysisSheetTitle = "분석"
+
+const (
+	analysisHeaderRow   = 2  // 표 헤더 행 (0-based, 위 두 행은 제목/빈 행)
+	analysisSideCol     = 7  // 카테고리 기울기 표/이미지 시작 열 (H)
+	analysisImageRows   = 18 // 그래프 이미지를 넣을 병합 영역 높이
+	analysisImageCols   = 8  // 그래프 이미지를 넣을 병합 영역 너비
+	defaultAnalysisDays = 30
+)
+
+// AnalysisOptions: 분석 탭 옵션
+// - Trend: 이동 평균/기울기/이상치 기준 기간과 z-score 기준
+// - Days: 표에 쓸 최근 기록 수 (0이면 30, 최신 날짜가 맨 위)
+// - ImageURL: 최신 그래프 이미지 공개 주소 (비면 이미지 생략, IMAGE 수식으로 삽입)
+// - Printer: 제목/헤더/이상치/카테고리 표시 언어 (0값은 한국어, 탭 이름은 언어와 관계없이 AnalysisSheetTitle)
+type AnalysisOptions struct {
+	Trend    analyzer.TrendOptions
+	Days     int
+	ImageURL string
+	Printer  i18n.Printer
+}
+
+func (o AnalysisOptions) days() int {
+	if o.Days <= 0 {
+		return defaultAnalysisDays
+	}
+	return o.Days
+}
+
+// analysisLayout: 분석 탭 값 배치 결과
+// - imageRow: 이미지 셀 행 (0-based, 이미지 없으면 -1)
+// - tr: 값에 쓴 언어 (이상치 조건부 서식이 같은 문구를 비교)
+type analysisLayout struct {
+	rows     [][]interface{}
+	days     int // 표에 쓴 일수
+	tableEnd int // 일자 표 마지막 행 다음 (0-based)
+	imageRow int
+	tr       i18n.Printer
+}
+
+// analysisRows: 분석 탭 값 구성
+// - A~F: 일자별 Focus 합계, 이동 평균, 기울기, z-score, 이상치 (최신 날짜가 위)
+// - H~I: 카테고리별 최근 기울기, 그 아래 최신 그래프 이미지
+// - data: 날짜 오름차순 전체 기록 (추세는 전체로 계산하고 최근 Days개만 표시)
+// - updated: 갱신 시각 (제목 옆에 표시)
+func analysisRows(data []common.FocusData, opts AnalysisOptions, updated time.Time) analysisLayout {
+	tr := opts.Printer
+	window := opts.Trend.WindowDays()
+	trends := analyzer.Trends(data, opts.Trend)
+	if len(trends) > opts.days() {
+		trends = trends[len(trends)-opts.days():]
+	}
+
+	width := analysisSideCol + analysisImageCols
+	grid := [][]interface{}{}
+	set := func(r, c int, v interface{}) {
+		for len(grid) <= r {
+			grid = append(grid, make([]interface{}, width))
+		}
+		grid[r][c] = v
+	}
+	set(0, 0, tr.T("analysis.title", len(trends)))
+	set(0, 1, tr.T("analysis.updated", updated.Format("2006-01-02 15:04")))
+
+	// 일자별 표
+	for c, h := range []string{tr.T("analysis.date"), tr.T("analysis.total"), tr.T("analysis.rolling", window), tr.T("analysis.slope", window), tr.T("analysis.zscore"), tr.T("analysis.anomaly")} {
+		set(analysisHeaderRow, c, h)
+	}
+	r := analysisHeaderRow + 1
+	for i := len(trends) - 1; i >= 0; i-- {
+		t := trends[i]
+		set(r, 0, t.Date)
+		set(r, 1, t.TotalFocus)
+		set(r, 2, round2(t.Rolling))
+		set(r, 3, round2(t.Slope))
+		set(r, 4, round2(t.ZScore))
+		set(r, 5, anomalyText(tr, t.Anomaly))
+		r++
+	}
+	layout := analysisLayout{days: len(trends), tableEnd: r, imageRow: -1, tr: tr}
+
+	// 카테고리별 기울기
+	set(analysisHeaderRow, analysisSideCol, tr.T("analysis.category"))
+	set(analysisHeaderRow, analysisSideCol+1, tr.T("analysis.slope", window))
+	slopes := analyzer.CategorySlopes(data, window)
+	r = analysisHeaderRow + 1
+	for _, cat := range common.Categories {
+		set(r, analysisSideCol, tr.Category(cat))
+		set(r, analysisSideCol+1, round2(slopes[cat]))
+		r++
+	}
+
+	// 최신 그래프 이미지 (병합 영역 왼쪽 위 셀에 IMAGE 수식)
+	if opts.ImageURL != "" {
+		layout.imageRow = r + 1
+		date := ""
+		if len(trends) > 0 {
+			date = trends[len(trends)-1].Date
+		}
+		set(layout.imageRow, analysisSideCol, imageFormula(opts.ImageURL, date))
+		set(layout.imageRow+analysisImageRows-1, analysisSideCol, nil)
+	}
+
+	// 빈 칸은 "" (USER_ENTERED 값 배열의 nil은 건너뜀)
+	for _, row := range grid {
+		for c := range row {
+			if row[c] == nil {
+				row[c] = ""
+			}
+		}
+	}
+	layout.rows = grid
+	return layout
+}
+
+// imageFormula: 그래프 주소 → IMAGE 수식 (날짜를 쿼리로 붙여 Sheets 이미지 캐시를 갱신)
+func imageFormula(url, date string) string {
+	if date != "" {
+		sep := "?"
+		if strings.Contains(url, "?") {
+			sep = "&"
+		}
+		url += sep + "v=" + date
+	}
+	return fmt.Sprintf(`=IMAGE("%s")`, strings.ReplaceAll(url, `"`, `""`))
+}
+
+// anomalyText: 이상치 표시 문구
+func anomalyText(tr i18n.Printer, a analyzer.Anomaly) string {
+	switch a {
+	case analyzer.AnomalyHigh:
+		return tr.T("analysis.high")
+	case analyzer.AnomalyLow:
+		return tr.T("analysis.low")
+	}
+	return ""
+}
+
+// round2: 소수 둘째 자리 반올림
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// analysisFormatRequests: 분석 탭 서식 (헤더 강조, 이상치 색, 이미지 영역 병합)
+func analysisFormatRequests(sheetID int64, layout analysisLayout) []*sheets.Request {
+	gray := &sheets.Color{Red: 0.95, Green: 0.95, Blue: 0.95}
+	header := func(from, to int) *sheets.Request {
+		return &sheets.Request{RepeatCell: &sheets.RepeatCellRequest{
+			Range:  &sheets.GridRange{SheetId: sheetID, StartRowIndex: analysisHeaderRow, EndRowIndex: analysisHeaderRow + 1, StartColumnIndex: int64(from), EndColumnIndex: int64(to)},
+			Cell:   &sheets.CellData{UserEnteredFormat: &sheets.CellFormat{BackgroundColor: gray, TextFormat: &sheets.TextFormat{Bold: true}}},
+			Fields: "userEnteredFormat(backgroundColor,textFormat.bold)",
+		}}
+	}
+	anomalyRule := func(text string, color *sheets.Color) *sheets.Request {
+		return &sheets.Request{AddConditionalFormatRule: &sheets.AddConditionalFormatRuleRequest{
+			Rule: &sheets.ConditionalFormatRule{
+				Ranges: []*sheets.GridRange{{SheetId: sheetID, StartRowIndex: analysisHeaderRow + 1, EndRowIndex: int64(layout.tableEnd), StartColumnIndex: 0, EndColumnIndex: 6}},
+				BooleanRule: &sheets.BooleanRule{
+					Condition: &sheets.BooleanCondition{
+						Type:   "CUSTOM_FORMULA",
+						Values: []*sheets.ConditionValue{{UserEnteredValue: fmt.Sprintf(`=$F%d="%s"`, analysisHeaderRow+2, text)}},
+					},
+					Format: &sheets.CellFormat{BackgroundColor: color},
+				},
+			},
+		}}
+	}
+	requests := []*sheets.Request{
+		{RepeatCell: &sheets.RepeatCellRequest{
+			Range:  &sheets.GridRange{SheetId: sheetID, StartRowIndex: 0, EndRowIndex: 1, StartColumnIndex: 0, EndColumnIndex: 1},
+			Cell:   &sheets.CellData{UserEnteredFormat: &sheets.CellFormat{TextFormat: &sheets.TextFormat{Bold: true}}},
+			Fields: "userEnteredFormat.textFormat.bold",
+		}},
+		header(0, 6),
+		header(analysisSideCol, analysisSideCol+2),
+	}
+	if layout.tableEnd > analysisHeaderRow+1 {
+		requests = append(requests,
+			anomalyRule(anomalyText(layout.tr, analyzer.AnomalyHigh), &sheets.Color{Red: 0.8, Green: 1.0, Blue: 0.8}),
+			anomalyRule(anomalyText(layout.tr, analyzer.AnomalyLow), &sheets.Color{Red: 1.0, Green: 0.8, Blue: 0.8}),
+		)
+	}
+	if layout.imageRow >= 0 {
+		requests = append(requests, &sheets.Request{MergeCells: &sheets.MergeCellsRequest{
+			Range: &sheets.GridRange{
+				SheetId:          sheetID,
+				StartRowIndex:    int64(layout.imageRow),
+				EndRowIndex:      int64(layout.imageRow + analysisImageRows),
+				StartColumnIndex: analysisSideCol,
+				EndColumnIndex:   analysisSideCol + analysisImageCols,
+			},
+			MergeType: "MERGE_ALL",
+		}})
+	}
+	return requests
+}
+
+// WriteAnalysis: 분석 결과(일자별 합계, 이동 평균, 회귀 기울기, 이상치, 최신 그래프)를 "분석" 탭에 다시 씀
+// - 탭은 매번 지우고 새로 만듦 (요약 탭 바로 뒤, 없으면 맨 앞)
+// - sheetsSrv: Google Sheets API 서비스
+// - spreadsheetID: 스프레드시트 ID
+// - data: 날짜 오름차순 전체 기록
+// - opts: 기간/표시 일수/이미지 주소
+// - now: 갱신 시각 (표시할 시간대로 변환해서 넘길 것)
+// 반환: 표에 쓴 일수, 에러
+func WriteAnalysis(sheetsSrv *sheets.Service, spreadsheetID string, data []common.FocusData, opts AnalysisOptions, now time.Time) (int, error) {
+	layout := analysisRows(data, opts, now)
+	err := writeGeneratedSheet(sheetsSrv, spreadsheetID, AnalysisSheetTitle, SummarySheetTitle, layout.rows, analysisSideCol+analysisImageCols, func(sheetID int64) []*sheets.Request {
+		return analysisFormatRequests(sheetID, layout)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return layout.days, nil
+}
@@ -0,0 +1,132 @@
+package sheets
+
+import (
+	"fmt"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"google.golang.org/api/sheets/v4"
+)
+
+// 월별 시트의 Label 컬럼 범위 (B, D, F, ... 31일치, 데이터 행 2부터 슬롯 수만큼)
+const (
+	labelDays        = 31
+	slotStartRow     = 1
+	categoryRuleType = "TEXT_EQ" // 카테고리 조건부 색상 규칙의 조건 타입
+)
+
+// labelRuleRequests: Label 컬럼 하나의 카테고리 드롭다운 + 카테고리별 조건부 색상 요청 (common.CategoryDefs 순서)
+// - labelCol: 0-based 컬럼 인덱스
+// - layout: 슬롯 구성 (데이터 행 수)
+func labelRuleRequests(sheetID int64, labelCol int, layout common.SlotLayout) []*sheets.Request {
+	labelRange := func() *sheets.GridRange {
+		return &sheets.GridRange{
+			SheetId:          sheetID,
+			StartRowIndex:    slotStartRow,
+			EndRowIndex:      slotEndRow(layout),
+			StartColumnIndex: int64(labelCol),
+			EndColumnIndex:   int64(labelCol + 1),
+		}
+	}
+	requests := []*sheets.Request{{
+		SetDataValidation: &sheets.SetDataValidationRequest{
+			Range: labelRange(),
+			Rule: &sheets.DataValidationRule{
+				Condition: &sheets.BooleanCondition{
+					Type:   "ONE_OF_LIST",
+					Values: toConditionValues(common.Categories),
+				},
+				Strict: true,
+			},
+		},
+	}}
+	for _, def := range common.CategoryDefs {
+		color := &sheets.Color{Red: float64(def.Color[0]), Green: float64(def.Color[1]), Blue: float64(def.Color[2])}
+		requests = append(requests, &sheets.Request{
+			AddConditionalFormatRule: &sheets.AddConditionalFormatRuleRequest{
+				Rule: &sheets.ConditionalFormatRule{
+					Ranges: []*sheets.GridRange{labelRange()},
+					BooleanRule: &sheets.BooleanRule{
+						Condition: &sheets.BooleanCondition{
+							Type:   categoryRuleType,
+							Values: []*sheets.ConditionValue{{UserEnteredValue: def.ID}},
+						},
+						Format: &sheets.CellFormat{BackgroundColor: color},
+					},
+				},
+				Index: 0,
+			},
+		})
+	}
+	return requests
+}
+
+// isLabelColorRule: applySheetStyles가 Label 컬럼에 넣은 카테고리 조건부 색상 규칙인지
+func isLabelColorRule(rule *sheets.ConditionalFormatRule) bool {
+	if rule.BooleanRule == nil || rule.BooleanRule.Condition == nil || rule.BooleanRule.Condition.Type != categoryRuleType {
+		return false
+	}
+	for _, r := range rule.Ranges {
+		col := r.StartColumnIndex
+		if col < 1 || col > 2*labelDays-1 || col%2 != 1 || r.EndColumnIndex != col+1 {
+			return false
+		}
+	}
+	return len(rule.Ranges) > 0
+}
+
+// categoryRuleUpdates: 월별 시트 하나의 카테고리 드롭다운/조건부 색상을 현재 정의로 바꾸는 요청
+// - 기존 카테고리 색상 규칙만 지우고 (다른 조건부 서식은 유지) 새 규칙 추가, 입력된 데이터는 건드리지 않음
+// - layout: 스프레드시트의 슬롯 구성
+func categoryRuleUpdates(sheet *sheets.Sheet, layout common.SlotLayout) []*sheets.Request {
+	sheetID := sheet.Properties.SheetId
+	requests := []*sheets.Request{}
+	// 뒤에서부터 지워야 앞 규칙의 인덱스가 바뀌지 않음
+	for i := len(sheet.ConditionalFormats) - 1; i >= 0; i-- {
+		if isLabelColorRule(sheet.ConditionalFormats[i]) {
+			requests = append(requests, &sheets.Request{
+				DeleteConditionalFormatRule: &sheets.DeleteConditionalFormatRuleRequest{SheetId: sheetID, Index: int64(i)},
+			})
+		}
+	}
+	for d := 0; d < labelDays; d++ {
+		requests = append(requests, labelRuleRequests(sheetID, 1+d*2, layout)...)
+	}
+	return requests
+}
+
+// UpdateCategoryRules: 기존 스프레드시트 월별 시트의 카테고리 드롭다운/조건부 색상을 현재 카테고리 정의로 갱신
+// - 시트 데이터/다른 서식은 그대로 두므로 카테고리를 추가·변경한 뒤 언제든 다시 실행 가능
+// - sheetsSrv: Google Sheets API 서비스
+// - spreadsheetID: 스프레드시트 ID
+// 반환: 갱신한 시트 수, 에러
+func UpdateCategoryRules(sheetsSrv *sheets.Service, spreadsheetID string) (int, error) {
+	ss, err := sheetsSrv.Spreadsheets.Get(spreadsheetID).Fields("developerMetadata,sheets(properties(sheetId,title),conditionalFormats)").Do()
+	if err != nil {
+		return 0, fmt.Errorf("스프레드시트 조회 실패: %w", err)
+	}
+	layout, _, err := slotLayoutOf(ss)
+	if err != nil {
+		return 0, err
+	}
+	months := map[string]bool{}
+	for m := 1; m <= 12; m++ {
+		months[fmt.Sprintf("%d월", m)] = true
+	}
+	requests := []*sheets.Request{}
+	updated := 0
+	for _, sheet := range ss.Sheets {
+		if sheet.Properties == nil || !months[sheet.Properties.Title] {
+			continue
+		}
+		requests = append(requests, categoryRuleUpdates(sheet, layout)...)
+		updated++
+	}
+	if len(requests) == 0 {
+		return 0, nil
+	}
+	_, err = sheetsSrv.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{Requests: requests}).Do()
+	if err != nil {
+		return 0, fmt.Errorf("카테고리 규칙 갱신 실패: %w", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,88 @@
+package sheets
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"google.golang.org/api/sheets/v4"
+)
+
+// slotMinutesKey: 슬롯 길이를 저장하는 스프레드시트 developer metadata 키
+const slotMinutesKey = "focus-time-tracker.slotMinutes"
+
+// SlotLayoutAPI: 스프레드시트의 슬롯 구성 조회 (SheetsAPI 구현체가 선택적으로 구현, 없으면 기본 구성)
+type SlotLayoutAPI interface {
+	GetSlotLayout(spreadsheetID string) (common.SlotLayout, error)
+}
+
+// GetSlotLayout: SlotLayoutAPI 구현
+func (r *RealSheetsAPI) GetSlotLayout(spreadsheetID string) (common.SlotLayout, error) {
+	return GetSlotLayout(r.srv, spreadsheetID)
+}
+
+// GetSlotLayout: 스프레드시트 developer metadata에 저장된 슬롯 구성 조회 (기록이 없는 이전 시트는 기본 10분)
+// - sheetsSrv: Google Sheets API 서비스
+// - spreadsheetID: 스프레드시트 ID
+// 반환: 슬롯 구성, 에러
+func GetSlotLayout(sheetsSrv *sheets.Service, spreadsheetID string) (common.SlotLayout, error) {
+	ss, err := sheetsSrv.Spreadsheets.Get(spreadsheetID).Fields("developerMetadata").Do()
+	if err != nil {
+		return common.SlotLayout{}, fmt.Errorf("슬롯 구성 조회 실패: %w", err)
+	}
+	layout, _, err := slotLayoutOf(ss)
+	return layout, err
+}
+
+// slotLayoutOf: 스프레드시트 metadata의 슬롯 구성
+// 반환: 슬롯 구성 (기록이 없으면 기본값), 기록 여부, 에러 (잘못된 값)
+func slotLayoutOf(ss *sheets.Spreadsheet) (common.SlotLayout, bool, error) {
+	for _, md := range ss.DeveloperMetadata {
+		if md.MetadataKey != slotMinutesKey {
+			continue
+		}
+		minutes, err := strconv.Atoi(md.MetadataValue)
+		if err != nil {
+			return common.SlotLayout{}, true, fmt.Errorf("슬롯 길이 metadata 형식 오류: %q", md.MetadataValue)
+		}
+		layout, err := common.NewSlotLayout(minutes)
+		return layout, true, err
+	}
+	return common.DefaultSlotLayout, false, nil
+}
+
+// slotLayoutRequest: 스프레드시트에 슬롯 길이 metadata를 기록하는 요청
+func slotLayoutRequest(layout common.SlotLayout) *sheets.Request {
+	return &sheets.Request{
+		CreateDeveloperMetadata: &sheets.CreateDeveloperMetadataRequest{
+			DeveloperMetadata: &sheets.DeveloperMetadata{
+				MetadataKey:   slotMinutesKey,
+				MetadataValue: strconv.Itoa(layout.SlotMinutes()),
+				Location:      &sheets.DeveloperMetadataLocation{Spreadsheet: true},
+				Visibility:    "DOCUMENT",
+			},
+		},
+	}
+}
+
+// writeSlotLayout: 스프레드시트에 슬롯 길이 metadata 기록
+func writeSlotLayout(sheetsSrv *sheets.Service, spreadsheetID string, layout common.SlotLayout) error {
+	_, err := sheetsSrv.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{slotLayoutRequest(layout)},
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("슬롯 구성 기록 실패: %w", err)
+	}
+	return nil
+}
+
+// slotEndRow: 데이터 마지막 행의 다음 행 인덱스 (0-based, 1행은 헤더)
+func slotEndRow(layout common.SlotLayout) int64 {
+	return int64(slotStartRow + layout.PerDay())
+}
+
+// dayRange: date일(1~31)의 Label:Focus 데이터 범위 A1 표기 (예: 10분 1일 → 'N월'!B2:C145)
+func dayRange(sheetName string, date int, layout common.SlotLayout) string {
+	startCol := 2 + (date-1)*2
+	return fmt.Sprintf("'%s'!%s2:%s%d", sheetName, colIdxToName(startCol), colIdxToName(startCol+1), slotEndRow(layout))
+}
@@ -3,6 +3,7 @@ package sheets
 import (
 	"fmt"
 
+	"github.com/crispy/focus-time-tracker/internal/common"
 	"github.com/crispy/focus-time-tracker/internal/config"
 	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/sheets/v4"
@@ -45,29 +46,38 @@ func createSpreadsheet(sheetsSrv *sheets.Service, driveSrv *drive.Service, title
 	return spreadsheetID, nil
 }
 
-// CreateYearlySheet: 연도별 시트 생성 후 월별 데이터 초기화 및 스타일 적용
+// CreateYearlySheet: 연도별 시트 생성 후 월별 데이터 초기화, 스타일 적용, 요약 탭 생성
 // - sheetsSrv: Google Sheets API 서비스
 // - driveSrv: Google Drive API 서비스
 // - title: 시트 제목
 // - year: 연도
+// - layout: 슬롯 구성 (스프레드시트 metadata에 기록되어 추출 시 다시 읽힘)
 // 반환: 생성된 스프레드시트 ID, 에러
-func CreateYearlySheet(sheetsSrv *sheets.Service, driveSrv *drive.Service, title string, year int) (string, error) {
+func CreateYearlySheet(sheetsSrv *sheets.Service, driveSrv *drive.Service, title string, year int, layout common.SlotLayout) (string, error) {
 	// 1. 스프레드시트 생성 및 폴더 이동
 	spreadsheetID, err := createSpreadsheet(sheetsSrv, driveSrv, title, year)
 	if err != nil {
 		return "", err
 	}
+	// 슬롯 길이 기록 (ExtractDailySlots가 읽음)
+	if err := writeSlotLayout(sheetsSrv, spreadsheetID, layout); err != nil {
+		return spreadsheetID, err
+	}
 	sheetTitles := []string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"}
 	for monthIdx, name := range sheetTitles {
 		// 2. 월별 시트 데이터 초기화
-		if err := initSheetData(sheetsSrv, spreadsheetID, name, year, monthIdx+1); err != nil {
+		if err := initSheetData(sheetsSrv, spreadsheetID, name, year, monthIdx+1, layout); err != nil {
 			return spreadsheetID, err
 		}
 		// 3. 월별 시트 스타일/유효성/조건부서식 적용
-		if err := applySheetStyles(sheetsSrv, spreadsheetID, name); err != nil {
+		if err := applySheetStyles(sheetsSrv, spreadsheetID, name, layout); err != nil {
 			return spreadsheetID, err
 		}
 	}
+	// 4. 요약 탭 (월별 탭 수식 + 차트)
+	if err := AddSummarySheet(sheetsSrv, spreadsheetID, year, layout); err != nil {
+		return spreadsheetID, err
+	}
 	return spreadsheetID, nil
 }
  
\ No newline at end of file
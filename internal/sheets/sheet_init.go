@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/crispy/focus-time-tracker/internal/common"
 	"google.golang.org/api/sheets/v4"
 )
 
@@ -12,16 +13,17 @@ import (
 // - spreadsheetID: 대상 스프레드시트 ID
 // - sheetName: 초기화할 시트 이름
 // - year, month: 연/월
+// - layout: 슬롯 구성 (시간 행 길이/개수)
 // 반환: 에러 (없으면 nil)
-func initSheetData(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, year, month int) error {
+func initSheetData(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, year, month int, layout common.SlotLayout) error {
 	const maxDays = 31 // 한 달 최대 일수
 	maxCols := 1 + maxDays*2 // 시간 열 + (일수*2: Label, Focus)
 	firstDay := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC) // 해당 월 1일
 	lastDay := firstDay.AddDate(0, 1, -1).Day() // 해당 월 마지막 일
 
 	// --- 헤더 행 생성 ---
-	// 첫 번째 열: 시간 범위 표시
-	row := []interface{}{fmt.Sprintf("시간 (%02d:00 ~ %02d:50)", 0, 23)}
+	// 첫 번째 열: 시간 범위 표시 (예: 10분 슬롯 → 00:00 ~ 23:50)
+	row := []interface{}{fmt.Sprintf("시간 (%s ~ %s)", layout.Key(0), layout.Key(layout.PerDay()-1))}
 	// 각 날짜별로 Label, Focus 열 추가
 	for d := 1; d <= lastDay; d++ {
 		date := time.Date(year, time.Month(month), d, 0, 0, 0, 0, time.UTC)
@@ -40,11 +42,9 @@ func initSheetData(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, y
 	rows = append(rows, row) // 첫 행: 헤더
 
 	// --- 시간표/빈 데이터 행 생성 ---
-	// 10분 단위(24*6=144) 시간 행 생성
-	for t := 0; t < 24*6; t++ {
-		hour := t / 6
-		min := (t % 6) * 10
-		timeStr := fmt.Sprintf("%02d:%02d", hour, min) // 예: 09:30
+	// 슬롯 단위 시간 행 생성 (예: 10분 → 24*6=144행)
+	for t := 0; t < layout.PerDay(); t++ {
+		timeStr := layout.Key(t) // 예: 09:30
 		row := []interface{}{timeStr} // 첫 열: 시간
 		// 각 날짜별로 Label, Focus 빈칸 추가
 		for d := 1; d <= lastDay; d++ {
@@ -73,14 +73,15 @@ func initSheetData(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, y
 
 // initSheetDataFrom: 월별 시트에 시간표/헤더/빈 데이터 초기화 (startDay부터)
 // - isStartMonth: true면 startDay 이전 날짜는 nil로 둬서 기존 데이터 보존
-func initSheetDataFrom(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, year, month, startDay int, isStartMonth bool) error {
+// - layout: 슬롯 구성 (스프레드시트에 기록된 값과 같아야 기존 데이터 행이 맞음)
+func initSheetDataFrom(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, year, month, startDay int, isStartMonth bool, layout common.SlotLayout) error {
 	const maxDays = 31
 	maxCols := 1 + maxDays*2
 	firstDay := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
 	lastDay := firstDay.AddDate(0, 1, -1).Day()
 
 	// --- 헤더 행 생성 ---
-	row := []interface{}{fmt.Sprintf("시간 (%02d:00 ~ %02d:50)", 0, 23)}
+	row := []interface{}{fmt.Sprintf("시간 (%s ~ %s)", layout.Key(0), layout.Key(layout.PerDay()-1))}
 	for d := 1; d <= lastDay; d++ {
 		date := time.Date(year, time.Month(month), d, 0, 0, 0, 0, time.UTC)
 		weekday := []rune("일월화수목금토")[date.Weekday()]
@@ -97,10 +98,8 @@ func initSheetDataFrom(sheetsSrv *sheets.Service, spreadsheetID, sheetName strin
 	rows = append(rows, row)
 
 	// --- 시간표/빈 데이터 행 생성 ---
-	for t := 0; t < 24*6; t++ {
-		hour := t / 6
-		min := (t % 6) * 10
-		timeStr := fmt.Sprintf("%02d:%02d", hour, min)
+	for t := 0; t < layout.PerDay(); t++ {
+		timeStr := layout.Key(t)
 		row := []interface{}{timeStr}
 		for d := 1; d <= lastDay; d++ {
 			if isStartMonth && d < startDay {
@@ -20,44 +20,40 @@ func colIdxToName(idx int) string {
 	return name
 }
 
-// ParseDailyData: 각 날짜, 각 카테고리별 [Label, Focus] 컬럼을 읽고, 값이 있는 데이터만 반환
+// ParseDailyData: 각 날짜, 각 카테고리별 [Label, Focus] 컬럼을 읽고, 빈 칸을 포함해 슬롯 순서대로 반환
 // - srv: Google Sheets API 서비스
 // - spreadsheetID: 스프레드시트 ID
 // - sheetName: 시트 이름
 // - dateCol: 날짜(1~31)
-// 반환: labels(카테고리명 배열), scores(점수 배열), 에러
+// 반환: labels(카테고리명 배열, 빈 칸은 ""), scores(점수 배열), 에러 (i번째 = i번째 슬롯, 길이 = 하루 슬롯 수)
 func ParseDailyData(srv *sheets.Service, spreadsheetID, sheetName string, dateCol int) (labels []string, scores []int, err error) {
-	rowCount := 144 // 24시간*6 (10분 단위)
+	layout, err := GetSlotLayout(srv, spreadsheetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rowCount := layout.PerDay() // 하루 슬롯 수 (10분 단위면 24시간*6)
 	// dateCol: 1일=1, 2일=2, ...
 	// 1일의 Label 컬럼 인덱스: 2 + (dateCol-1)*2
-	startCol := 2 + (dateCol-1)*2
-	endCol := startCol + 1
-	startColName := colIdxToName(startCol)
-	endColName := colIdxToName(endCol)
-	rangeStr := fmt.Sprintf("'%s'!%s2:%s145", sheetName, startColName, endColName)
+	rangeStr := dayRange(sheetName, dateCol, layout)
 	resp, err := srv.Spreadsheets.Values.Get(spreadsheetID, rangeStr).Do()
 	if err != nil {
 		return nil, nil, err
 	}
+	labels = make([]string, rowCount)
+	scores = make([]int, rowCount)
 	for i := 0; i < rowCount; i++ {
 		row := []interface{}{}
 		if i < len(resp.Values) {
 			row = resp.Values[i]
 		}
-		label := ""
-		score := 0
 		if len(row) > 0 {
-			label = fmt.Sprintf("%v", row[0])
+			labels[i] = fmt.Sprintf("%v", row[0])
 		}
 		if len(row) > 1 {
 			if v, err := strconv.Atoi(fmt.Sprintf("%v", row[1])); err == nil {
-				score = v
+				scores[i] = v
 			}
 		}
-		if label != "" {
-			labels = append(labels, label)
-			scores = append(scores, score)
-		}
 	}
 	return labels, scores, nil
 }
@@ -11,16 +11,11 @@ import (
 // - sheetsSrv: Google Sheets API 서비스
 // - spreadsheetID: 스프레드시트 ID
 // - sheetName: 시트 이름
+// - layout: 슬롯 구성 (1시간 경계선, 유효성 범위)
 // 반환: 에러 (없으면 nil)
-func applySheetStyles(sheetsSrv *sheets.Service, spreadsheetID, sheetName string) error {
+func applySheetStyles(sheetsSrv *sheets.Service, spreadsheetID, sheetName string, layout common.SlotLayout) error {
 	const maxDays = 31
 	const maxCols = 1 + maxDays*2 // 시간 + (31일*2)
-	categories := common.Categories
-	labelColors := map[string]*sheets.Color{} // 카테고리별 색상 매핑
-	for _, cat := range categories {
-		rgb := common.CategoryColors[cat]
-		labelColors[cat] = &sheets.Color{Red: float64(rgb[0]), Green: float64(rgb[1]), Blue: float64(rgb[2])}
-	}
 	gray := &sheets.Color{Red: 0.95, Green: 0.95, Blue: 0.95}
 	black := &sheets.Color{Red: 0, Green: 0, Blue: 0}
 
@@ -71,14 +66,15 @@ func applySheetStyles(sheetsSrv *sheets.Service, spreadsheetID, sheetName string
 			InnerVertical:   &sheets.Border{Style: "SOLID", Color: black},
 		},
 	})
-	// 2-2. 1시간(6행)마다 굵은 border
+	// 2-2. 1시간(PerHour행)마다 굵은 border
+	perHour := layout.PerHour()
 	for h := 1; h < 24; h++ {
 		requests = append(requests, &sheets.Request{
 			UpdateBorders: &sheets.UpdateBordersRequest{
 				Range: &sheets.GridRange{
 					SheetId:       sheetID,
-					StartRowIndex: int64(1 + h*6 - 1),
-					EndRowIndex:   int64(1 + h*6),
+					StartRowIndex: int64(1 + h*perHour - 1),
+					EndRowIndex:   int64(1 + h*perHour),
 				},
 				Bottom: &sheets.Border{Style: "SOLID_MEDIUM", Color: black},
 			},
@@ -91,32 +87,15 @@ func applySheetStyles(sheetsSrv *sheets.Service, spreadsheetID, sheetName string
 		if labelCol >= maxCols || focusCol >= maxCols {
 			break
 		}
-		// Label 드롭다운
-		requests = append(requests, &sheets.Request{
-			SetDataValidation: &sheets.SetDataValidationRequest{
-				Range: &sheets.GridRange{
-					SheetId:          sheetID,
-					StartRowIndex:    1,
-					EndRowIndex:      145,
-					StartColumnIndex: int64(labelCol),
-					EndColumnIndex:   int64(labelCol + 1),
-				},
-				Rule: &sheets.DataValidationRule{
-					Condition: &sheets.BooleanCondition{
-						Type:   "ONE_OF_LIST",
-						Values: toConditionValues(categories),
-					},
-					Strict: true,
-				},
-			},
-		})
+		// Label 드롭다운 + 카테고리 조건부 색상
+		requests = append(requests, labelRuleRequests(sheetID, labelCol, layout)...)
 		// Focus 숫자만
 		requests = append(requests, &sheets.Request{
 			SetDataValidation: &sheets.SetDataValidationRequest{
 				Range: &sheets.GridRange{
 					SheetId:          sheetID,
 					StartRowIndex:    1,
-					EndRowIndex:      145,
+					EndRowIndex:      slotEndRow(layout),
 					StartColumnIndex: int64(focusCol),
 					EndColumnIndex:   int64(focusCol + 1),
 				},
@@ -129,30 +108,6 @@ func applySheetStyles(sheetsSrv *sheets.Service, spreadsheetID, sheetName string
 				},
 			},
 		})
-		// Label 조건부 색상
-		for label, color := range labelColors {
-			requests = append(requests, &sheets.Request{
-				AddConditionalFormatRule: &sheets.AddConditionalFormatRuleRequest{
-					Rule: &sheets.ConditionalFormatRule{
-						Ranges: []*sheets.GridRange{{
-							SheetId:          sheetID,
-							StartRowIndex:    1,
-							EndRowIndex:      145,
-							StartColumnIndex: int64(labelCol),
-							EndColumnIndex:   int64(labelCol + 1),
-						}},
-						BooleanRule: &sheets.BooleanRule{
-							Condition: &sheets.BooleanCondition{
-								Type:   "TEXT_EQ",
-								Values: []*sheets.ConditionValue{{UserEnteredValue: label}},
-							},
-							Format: &sheets.CellFormat{BackgroundColor: color},
-						},
-					},
-					Index: 0,
-				},
-			})
-		}
 	}
 	if len(requests) > 0 {
 		_, err := sheetsSrv.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
@@ -85,55 +85,64 @@ func FindSpreadsheetIDByYearAPI(ctx context.Context, driveAPI DriveAPI, folderID
 	return files[0].Id, nil
 }
 
-// ExtractDailyFocusDataAPI: 특정 연/월/일의 시트 데이터(라벨, 집중도) 추출 및 FocusData 집계 (mockable)
-// - sheetsAPI: SheetsAPI 인터페이스
-// - spreadsheetID, year, month, day: 기존과 동일
-// 반환: FocusData, 날짜 문자열(YYYY-MM-DD), 에러
-func ExtractDailyFocusDataAPI(sheetsAPI SheetsAPI, spreadsheetID string, year, month, day int) (common.FocusData, string, error) {
+// ExtractDailySlotsAPI: 특정 연/월/일의 시트 슬롯 하루치(라벨, 집중도)를 빈 칸 포함 그대로 추출 (mockable)
+// - sheetsAPI: SheetsAPI 인터페이스 (SlotLayoutAPI도 구현하면 스프레드시트에 기록된 슬롯 길이 사용, 아니면 기본 10분)
+// - spreadsheetID, year, month, day: 대상 스프레드시트와 날짜
+// 반환: DaySlots (Labels/Scores 길이 = 하루 슬롯 수, 10분이면 144), 에러
+func ExtractDailySlotsAPI(sheetsAPI SheetsAPI, spreadsheetID string, year, month, day int) (common.DaySlots, error) {
 	loc, err := time.LoadLocation("Asia/Seoul")
 	if err != nil {
-		return common.FocusData{}, "", err
+		return common.DaySlots{}, err
+	}
+	layout := common.DefaultSlotLayout
+	if layoutAPI, ok := sheetsAPI.(SlotLayoutAPI); ok {
+		if layout, err = layoutAPI.GetSlotLayout(spreadsheetID); err != nil {
+			return common.DaySlots{}, err
+		}
 	}
 	sheetName := fmt.Sprintf("%d월", month)
 	dateCol := day // 1일=1, 2일=2, ...
-	startCol := 2 + (dateCol-1)*2
-	endCol := startCol + 1
-	startColName := colIdxToName(startCol)
-	endColName := colIdxToName(endCol)
-	rangeStr := fmt.Sprintf("'%s'!%s2:%s145", sheetName, startColName, endColName)
+	rangeStr := dayRange(sheetName, dateCol, layout)
 	values, err := sheetsAPI.GetValues(spreadsheetID, rangeStr)
 	if err != nil {
-		return common.FocusData{}, "", err
+		return common.DaySlots{}, err
 	}
-	labels := []string{}
-	scores := []int{}
-	for i := 0; i < 144; i++ {
+	n := layout.PerDay()
+	slots := common.DaySlots{
+		Date:        time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc).Format("2006-01-02"),
+		Labels:      make([]string, n),
+		Scores:      make([]int, n),
+		SlotMinutes: layout.SlotMinutes(),
+	}
+	for i := 0; i < n; i++ {
 		row := []interface{}{}
 		if i < len(values) {
 			row = values[i]
 		}
-		label := ""
-		score := 0
 		if len(row) > 0 {
-			label = fmt.Sprintf("%v", row[0])
+			slots.Labels[i] = fmt.Sprintf("%v", row[0])
 		}
 		if len(row) > 1 {
+			score := 0
 			if v, err := fmt.Sscanf(fmt.Sprintf("%v", row[1]), "%d", &score); v == 1 && err == nil {
-				// score already set
-			} else {
-				score = 0
+				slots.Scores[i] = score
 			}
 		}
-		if label != "" {
-			labels = append(labels, label)
-			scores = append(scores, score)
-		}
 	}
-	data := analyzer.AnalyzeFocus(labels, scores)
-	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
-	dateStr := date.Format("2006-01-02")
-	data.Date = dateStr
-	return data, dateStr, nil
+	return slots, nil
+}
+
+// ExtractDailyFocusDataAPI: 특정 연/월/일의 시트 데이터(라벨, 집중도) 추출 및 FocusData 집계 (mockable)
+// - sheetsAPI: SheetsAPI 인터페이스
+// - spreadsheetID, year, month, day: 기존과 동일
+// 반환: FocusData, 날짜 문자열(YYYY-MM-DD), 에러
+func ExtractDailyFocusDataAPI(sheetsAPI SheetsAPI, spreadsheetID string, year, month, day int) (common.FocusData, string, error) {
+	slots, err := ExtractDailySlotsAPI(sheetsAPI, spreadsheetID, year, month, day)
+	if err != nil {
+		return common.FocusData{}, "", err
+	}
+	data := analyzer.AnalyzeDaySlots(slots)
+	return data, slots.Date, nil
 }
 
 // (기존 함수는 deprecated, 테스트/실제 코드에서 위 API 기반 함수 사용 권장)
@@ -142,6 +151,11 @@ func FindSpreadsheetIDByYear(ctx context.Context, driveSrv *drive.Service, folde
 	driveAPI := &RealDriveAPI{srv: driveSrv}
 	return FindSpreadsheetIDByYearAPI(ctx, driveAPI, folderID, year)
 }
+// ExtractDailySlots: *sheets.Service로 ExtractDailySlotsAPI 호출
+func ExtractDailySlots(sheetsSrv *sheets.Service, spreadsheetID string, year, month, day int) (common.DaySlots, error) {
+	sheetsAPI := &RealSheetsAPI{srv: sheetsSrv}
+	return ExtractDailySlotsAPI(sheetsAPI, spreadsheetID, year, month, day)
+}
 // ExtractDailyFocusData: deprecated, 테스트에서는 ExtractDailyFocusDataAPI 사용
 func ExtractDailyFocusData(sheetsSrv *sheets.Service, spreadsheetID string, year, month, day int) (common.FocusData, string, error) {
 	sheetsAPI := &RealSheetsAPI{srv: sheetsSrv}
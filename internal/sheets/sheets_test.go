@@ -3,11 +3,16 @@ package sheets
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
 	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/api/drive/v3"
+	sheetsv4 "google.golang.org/api/sheets/v4"
 )
 
 // FakeSheetsService는 sheets.Service의 최소 mock 구조체
@@ -83,3 +88,201 @@ func TestExtractDailyFocusDataAPI_Property(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 0, data.TotalFocus)
 }
+
+func TestExtractDailySlotsAPI_KeepsEmptyCells(t *testing.T) {
+	sheetsAPI := &MockSheetsAPI{values: [][]interface{}{{"업무", 10}, {}, {"학습", "x"}}}
+	slots, err := ExtractDailySlotsAPI(sheetsAPI, "spreadsheetID", 2024, 6, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-06-01", slots.Date)
+	assert.Len(t, slots.Labels, 144)
+	assert.Len(t, slots.Scores, 144)
+	assert.Equal(t, "업무", slots.Labels[0])
+	assert.Equal(t, 10, slots.Scores[0])
+	assert.Equal(t, "", slots.Labels[1])
+	assert.Equal(t, "학습", slots.Labels[2])
+	assert.Equal(t, 0, slots.Scores[2])
+}
+
+func TestCategoryRuleUpdates(t *testing.T) {
+	t.Cleanup(func() { common.SetCategories(common.DefaultCategoryDefs) })
+	assert.NoError(t, common.SetCategories([]common.CategoryDef{
+		{ID: "코딩", Color: [3]float32{1, 0, 0}, Counted: true},
+		{ID: "휴식", Color: [3]float32{0, 1, 0}},
+	}))
+	textEq := func(col int64, value string) *sheetsv4.ConditionalFormatRule {
+		return &sheetsv4.ConditionalFormatRule{
+			Ranges: []*sheetsv4.GridRange{{StartColumnIndex: col, EndColumnIndex: col + 1}},
+			BooleanRule: &sheetsv4.BooleanRule{Condition: &sheetsv4.BooleanCondition{
+				Type: "TEXT_EQ", Values: []*sheetsv4.ConditionValue{{UserEnteredValue: value}},
+			}},
+		}
+	}
+	sheet := &sheetsv4.Sheet{
+		Properties: &sheetsv4.SheetProperties{SheetId: 7, Title: "5월"},
+		ConditionalFormats: []*sheetsv4.ConditionalFormatRule{
+			textEq(1, "업무"),
+			textEq(2, "다른 규칙"), // Focus 컬럼: 카테고리 규칙 아님
+			textEq(3, "학습"),
+		},
+	}
+	requests := categoryRuleUpdates(sheet, common.DefaultSlotLayout)
+
+	// 기존 카테고리 규칙만 뒤에서부터 삭제
+	assert.Equal(t, int64(2), requests[0].DeleteConditionalFormatRule.Index)
+	assert.Equal(t, int64(0), requests[1].DeleteConditionalFormatRule.Index)
+	assert.Nil(t, requests[2].DeleteConditionalFormatRule)
+
+	// 31일 × (드롭다운 1 + 카테고리 2)
+	added := requests[2:]
+	assert.Len(t, added, 31*3)
+	validation := added[0].SetDataValidation
+	assert.Equal(t, int64(7), validation.Range.SheetId)
+	assert.Equal(t, int64(1), validation.Range.StartColumnIndex)
+	assert.Equal(t, "코딩", validation.Rule.Condition.Values[0].UserEnteredValue)
+	assert.Equal(t, "휴식", validation.Rule.Condition.Values[1].UserEnteredValue)
+	rule := added[2].AddConditionalFormatRule.Rule
+	assert.Equal(t, "휴식", rule.BooleanRule.Condition.Values[0].UserEnteredValue)
+	assert.Equal(t, 1.0, rule.BooleanRule.Format.BackgroundColor.Green)
+	assert.True(t, isLabelColorRule(rule))
+	assert.Equal(t, int64(61), added[len(added)-3].SetDataValidation.Range.StartColumnIndex)
+}
+
+type MockLayoutSheetsAPI struct {
+	MockSheetsAPI
+	layout    common.SlotLayout
+	readRange string
+}
+func (m *MockLayoutSheetsAPI) GetValues(spreadsheetID, readRange string) ([][]interface{}, error) {
+	m.readRange = readRange
+	return m.MockSheetsAPI.GetValues(spreadsheetID, readRange)
+}
+func (m *MockLayoutSheetsAPI) GetSlotLayout(spreadsheetID string) (common.SlotLayout, error) {
+	return m.layout, nil
+}
+
+func TestExtractDailySlotsAPI_SlotLayout(t *testing.T) {
+	sheetsAPI := &MockLayoutSheetsAPI{MockSheetsAPI: MockSheetsAPI{values: [][]interface{}{{"업무", 3}, {}, {"학습", 4}}}, layout: common.SlotLayout{Minutes: 30}}
+	slots, err := ExtractDailySlotsAPI(sheetsAPI, "spreadsheetID", 2024, 6, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "'6월'!D2:E49", sheetsAPI.readRange)
+	assert.Len(t, slots.Labels, 48)
+	assert.Equal(t, 30, slots.SlotMinutes)
+
+	data, _, err := ExtractDailyFocusDataAPI(sheetsAPI, "spreadsheetID", 2024, 6, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, data.SlotMinutes)
+	assert.Equal(t, 4, data.TimeSlots["01:00"])
+
+	// SlotLayoutAPI를 구현하지 않으면 기본 10분
+	slots, err = ExtractDailySlotsAPI(&MockSheetsAPI{}, "spreadsheetID", 2024, 6, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, common.DefaultSlotMinutes, slots.SlotMinutes)
+}
+
+func TestSlotLayoutOf(t *testing.T) {
+	layout, recorded, err := slotLayoutOf(&sheetsv4.Spreadsheet{})
+	assert.NoError(t, err)
+	assert.False(t, recorded)
+	assert.Equal(t, common.DefaultSlotLayout, layout)
+
+	md := slotLayoutRequest(common.SlotLayout{Minutes: 15}).CreateDeveloperMetadata.DeveloperMetadata
+	assert.True(t, md.Location.Spreadsheet)
+	layout, recorded, err = slotLayoutOf(&sheetsv4.Spreadsheet{DeveloperMetadata: []*sheetsv4.DeveloperMetadata{{MetadataKey: "other", MetadataValue: "x"}, md}})
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+	assert.Equal(t, 96, layout.PerDay())
+
+	_, _, err = slotLayoutOf(&sheetsv4.Spreadsheet{DeveloperMetadata: []*sheetsv4.DeveloperMetadata{{MetadataKey: slotMinutesKey, MetadataValue: "7"}}})
+	assert.Error(t, err)
+
+	// 5분 슬롯이면 데이터 행 2~289
+	assert.Equal(t, int64(289), slotEndRow(common.SlotLayout{Minutes: 5}))
+	assert.Equal(t, int64(289), labelRuleRequests(1, 1, common.SlotLayout{Minutes: 5})[0].SetDataValidation.Range.EndRowIndex)
+}
+
+func TestSummaryRows(t *testing.T) {
+	rows := summaryRows(2024, common.DefaultSlotLayout)
+	assert.Len(t, rows, 1+366) // 헤더 + 윤년 하루 한 행
+	cols := 1 + len(common.Categories) + 2
+	assert.Equal(t, "날짜", rows[0][0])
+	assert.Equal(t, "업무 (분)", rows[0][1])
+	assert.Equal(t, "효율", rows[0][cols-1])
+
+	// 6월 2일 (1월 1일부터 154번째 날, 시트 155행)
+	row := rows[154]
+	assert.Len(t, row, cols)
+	assert.Equal(t, "2024-06-02", row[0])
+	assert.Equal(t, `=COUNTIF('6월'!D2:D145,"업무")*10`, row[1])
+	focus := row[cols-2].(string)
+	assert.Contains(t, focus, `SUMIF('6월'!D2:D145,"업무",'6월'!E2:E145)`)
+	assert.NotContains(t, focus, `"이동"`) // 총점 제외 카테고리
+	assert.Equal(t, `=IFERROR(K155/((B155+C155+D155+E155+G155+H155+I155+J155)/10*5),"")`, row[cols-1])
+
+	// 30분 슬롯이면 49행까지, 칸 수 × 30분
+	rows = summaryRows(2023, common.SlotLayout{Minutes: 30})
+	assert.Len(t, rows, 1+365)
+	assert.Equal(t, `=COUNTIF('12월'!BJ2:BJ49,"업무")*30`, rows[365][1])
+
+	charts := summaryChartRequests(9, cols, len(rows))
+	assert.Len(t, charts, 2)
+	stacked := charts[0].AddChart.Chart.Spec.BasicChart
+	assert.Equal(t, "STACKED", stacked.StackedType)
+	assert.Len(t, stacked.Series, len(common.Categories))
+	assert.Equal(t, int64(cols+1), charts[0].AddChart.Chart.Position.OverlayPosition.AnchorCell.ColumnIndex)
+	line := charts[1].AddChart.Chart.Spec.BasicChart
+	assert.Equal(t, int64(cols-1), line.Series[1].Series.SourceRange.Sources[0].StartColumnIndex)
+	assert.Equal(t, "RIGHT_AXIS", line.Series[1].TargetAxis)
+}
+
+func TestAnalysisRows(t *testing.T) {
+	data := []common.FocusData{}
+	for i, v := range []int{10, 12, 11, 13, 40} {
+		data = append(data, common.FocusData{Date: fmt.Sprintf("2024-06-%02d", i+1), TotalFocus: v, Categories: map[string]int{"업무": v}})
+	}
+	opts := AnalysisOptions{Trend: analyzer.TrendOptions{Window: 3}, Days: 4, ImageURL: "https://example.com/graph.png?raw=1"}
+	layout := analysisRows(data, opts, time.Date(2024, 6, 6, 7, 30, 0, 0, time.UTC))
+	assert.Equal(t, 4, layout.days)
+	assert.Equal(t, "최근 4일 분석", layout.rows[0][0])
+	assert.Equal(t, "갱신: 2024-06-06 07:30", layout.rows[0][1])
+	assert.Equal(t, []interface{}{"날짜", "Focus 합계", "3일 평균", "3일 기울기", "z-score", "이상치"}, layout.rows[2][:6])
+
+	// 최신 날짜가 맨 위, 가장 오래된 2024-06-01은 Days 밖
+	assert.Equal(t, "2024-06-05", layout.rows[3][0])
+	assert.Equal(t, 40, layout.rows[3][1])
+	assert.Equal(t, 21.33, layout.rows[3][2])
+	assert.Equal(t, "▲ 높음", layout.rows[3][5])
+	assert.Equal(t, "2024-06-02", layout.rows[6][0])
+	assert.Equal(t, 7, layout.tableEnd)
+
+	// 카테고리 기울기 + 이미지 (모든 행 길이가 같고 빈 칸은 "")
+	assert.Equal(t, "업무", layout.rows[3][analysisSideCol])
+	assert.Equal(t, 14.5, layout.rows[3][analysisSideCol+1])
+	assert.Equal(t, analysisHeaderRow+1+len(common.Categories)+1, layout.imageRow)
+	assert.Equal(t, `=IMAGE("https://example.com/graph.png?raw=1&v=2024-06-05")`, layout.rows[layout.imageRow][analysisSideCol])
+	assert.Len(t, layout.rows, layout.imageRow+analysisImageRows)
+	for _, row := range layout.rows {
+		assert.Len(t, row, analysisSideCol+analysisImageCols)
+		assert.NotContains(t, row, nil)
+	}
+	requests := analysisFormatRequests(3, layout)
+	merge := requests[len(requests)-1].MergeCells
+	assert.Equal(t, int64(layout.imageRow), merge.Range.StartRowIndex)
+
+	// 이미지 주소가 없으면 이미지/병합 생략
+	layout = analysisRows(data, AnalysisOptions{}, time.Now())
+	assert.Equal(t, -1, layout.imageRow)
+	assert.Equal(t, 5, layout.days)
+	for _, r := range analysisFormatRequests(3, layout) {
+		assert.Nil(t, r.MergeCells)
+	}
+
+	// Printer 언어로 제목/헤더/이상치/카테고리 표시, 조건부 서식도 같은 문구
+	layout = analysisRows(data, AnalysisOptions{Trend: analyzer.TrendOptions{Window: 3}, Printer: i18n.New(i18n.English)}, time.Date(2024, 6, 6, 7, 30, 0, 0, time.UTC))
+	assert.Equal(t, "Analysis of the last 5 days", layout.rows[0][0])
+	assert.Equal(t, "Updated: 2024-06-06 07:30", layout.rows[0][1])
+	assert.Equal(t, []interface{}{"Date", "Total focus", "3-day average", "3-day slope", "z-score", "Anomaly"}, layout.rows[2][:6])
+	assert.Equal(t, "▲ High", layout.rows[3][5])
+	assert.Equal(t, "Work", layout.rows[3][analysisSideCol])
+	rule := analysisFormatRequests(3, layout)[3].AddConditionalFormatRule.Rule
+	assert.Equal(t, `=$F4="▲ High"`, rule.BooleanRule.Condition.Values[0].UserEnteredValue)
+}
@@ -0,0 +1,258 @@
+package sheets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"google.golang.org/api/sheets/v4"
+)
+
+// SummarySheetTitle: 연간 요약 탭 이름 (월별 탭 수식으로 계산, 다시 만들면 통째로 교체)
+const SummarySheetTitle = "요약"
+
+const summaryChartWidth = 10 // 차트를 놓을 표 오른쪽 여유 열 수
+
+// summaryHeader: 요약 탭 1행 (날짜, 카테고리별 분, Focus 합계, 효율)
+func summaryHeader() []interface{} {
+	row := []interface{}{"날짜"}
+	for _, cat := range common.Categories {
+		row = append(row, cat+" (분)")
+	}
+	return append(row, "Focus 합계", "효율")
+}
+
+// summaryRows: 요약 탭 전체 값 (헤더 + 1월 1일~12월 31일 하루 한 행, 셀은 월별 탭을 참조하는 수식)
+// - 카테고리 분: Label 컬럼에서 카테고리 칸 수 × 슬롯 길이
+// - Focus 합계: 총점 합산 카테고리 칸의 Focus 합 (TotalFocus와 같은 기준)
+// - 효율: Focus 합계 / (총점 합산 카테고리 칸 수 × common.MaxSlotScore)
+// - layout: 스프레드시트의 슬롯 구성
+func summaryRows(year int, layout common.SlotLayout) [][]interface{} {
+	rows := [][]interface{}{summaryHeader()}
+	focusCol := colIdxToName(2 + len(common.Categories))
+	end := slotEndRow(layout)
+	for d := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC); d.Year() == year; d = d.AddDate(0, 0, 1) {
+		r := len(rows) + 1 // 1-based 행 번호
+		labelName := colIdxToName(2 + (d.Day()-1)*2)
+		focusName := colIdxToName(3 + (d.Day()-1)*2)
+		labels := fmt.Sprintf("'%d월'!%s2:%s%d", int(d.Month()), labelName, labelName, end)
+		scores := fmt.Sprintf("'%d월'!%s2:%s%d", int(d.Month()), focusName, focusName, end)
+
+		row := []interface{}{d.Format("2006-01-02")}
+		sums, counted := []string{}, []string{}
+		for i, cat := range common.Categories {
+			quoted := strings.ReplaceAll(cat, `"`, `""`)
+			row = append(row, fmt.Sprintf(`=COUNTIF(%s,"%s")*%d`, labels, quoted, layout.SlotMinutes()))
+			if common.IsCounted(cat) {
+				sums = append(sums, fmt.Sprintf(`SUMIF(%s,"%s",%s)`, labels, quoted, scores))
+				counted = append(counted, fmt.Sprintf("%s%d", colIdxToName(2+i), r))
+			}
+		}
+		if len(sums) == 0 {
+			row = append(row, 0, "")
+		} else {
+			row = append(row,
+				"="+strings.Join(sums, "+"),
+				fmt.Sprintf(`=IFERROR(%s%d/((%s)/%d*%d),"")`, focusCol, r, strings.Join(counted, "+"), layout.SlotMinutes(), common.MaxSlotScore),
+			)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// summaryFormatRequests: 요약 탭 서식 (헤더/날짜 열 고정, 날짜/백분율 형식)
+// - cols: 표 열 수, rows: 헤더 포함 행 수
+func summaryFormatRequests(sheetID int64, cols, rows int) []*sheets.Request {
+	gray := &sheets.Color{Red: 0.95, Green: 0.95, Blue: 0.95}
+	column := func(c int) *sheets.GridRange {
+		return &sheets.GridRange{SheetId: sheetID, StartRowIndex: 1, EndRowIndex: int64(rows), StartColumnIndex: int64(c), EndColumnIndex: int64(c + 1)}
+	}
+	return []*sheets.Request{
+		{
+			UpdateSheetProperties: &sheets.UpdateSheetPropertiesRequest{
+				Properties: &sheets.SheetProperties{
+					SheetId:        sheetID,
+					GridProperties: &sheets.GridProperties{FrozenRowCount: 1, FrozenColumnCount: 1},
+				},
+				Fields: "gridProperties.frozenRowCount,gridProperties.frozenColumnCount",
+			},
+		},
+		{
+			RepeatCell: &sheets.RepeatCellRequest{
+				Range:  &sheets.GridRange{SheetId: sheetID, StartRowIndex: 0, EndRowIndex: 1, StartColumnIndex: 0, EndColumnIndex: int64(cols)},
+				Cell:   &sheets.CellData{UserEnteredFormat: &sheets.CellFormat{BackgroundColor: gray, TextFormat: &sheets.TextFormat{Bold: true}}},
+				Fields: "userEnteredFormat(backgroundColor,textFormat.bold)",
+			},
+		},
+		{
+			RepeatCell: &sheets.RepeatCellRequest{
+				Range:  column(0),
+				Cell:   &sheets.CellData{UserEnteredFormat: &sheets.CellFormat{NumberFormat: &sheets.NumberFormat{Type: "DATE", Pattern: "yyyy-mm-dd"}}},
+				Fields: "userEnteredFormat.numberFormat",
+			},
+		},
+		{
+			RepeatCell: &sheets.RepeatCellRequest{
+				Range:  column(cols - 1),
+				Cell:   &sheets.CellData{UserEnteredFormat: &sheets.CellFormat{NumberFormat: &sheets.NumberFormat{Type: "PERCENT", Pattern: "0.0%"}}},
+				Fields: "userEnteredFormat.numberFormat",
+			},
+		},
+	}
+}
+
+// summaryChartRequests: 요약 탭 차트 (일자별 카테고리 분 누적 막대, Focus 합계/효율 꺾은선)
+// - cols: 표 열 수, rows: 헤더 포함 행 수 (차트는 표 오른쪽에 배치)
+func summaryChartRequests(sheetID int64, cols, rows int) []*sheets.Request {
+	data := func(c int) *sheets.ChartData {
+		return &sheets.ChartData{SourceRange: &sheets.ChartSourceRange{Sources: []*sheets.GridRange{{
+			SheetId: sheetID, StartRowIndex: 0, EndRowIndex: int64(rows), StartColumnIndex: int64(c), EndColumnIndex: int64(c + 1),
+		}}}}
+	}
+	position := func(row int) *sheets.EmbeddedObjectPosition {
+		return &sheets.EmbeddedObjectPosition{OverlayPosition: &sheets.OverlayPosition{
+			AnchorCell:   &sheets.GridCoordinate{SheetId: sheetID, RowIndex: int64(row), ColumnIndex: int64(cols + 1)},
+			WidthPixels:  900,
+			HeightPixels: 360,
+		}}
+	}
+	domain := []*sheets.BasicChartDomain{{Domain: data(0)}}
+
+	minutes := []*sheets.BasicChartSeries{}
+	for i, cat := range common.Categories {
+		c := common.CategoryColors[cat]
+		minutes = append(minutes, &sheets.BasicChartSeries{
+			Series:     data(1 + i),
+			TargetAxis: "LEFT_AXIS",
+			Color:      &sheets.Color{Red: float64(c[0]), Green: float64(c[1]), Blue: float64(c[2])},
+		})
+	}
+	return []*sheets.Request{
+		{
+			AddChart: &sheets.AddChartRequest{Chart: &sheets.EmbeddedChart{
+				Spec: &sheets.ChartSpec{
+					Title: "일자별 카테고리 시간 (분)",
+					BasicChart: &sheets.BasicChartSpec{
+						ChartType:      "COLUMN",
+						StackedType:    "STACKED",
+						LegendPosition: "BOTTOM_LEGEND",
+						HeaderCount:    1,
+						Axis: []*sheets.BasicChartAxis{
+							{Position: "BOTTOM_AXIS", Title: "날짜"},
+							{Position: "LEFT_AXIS", Title: "분"},
+						},
+						Domains: domain,
+						Series:  minutes,
+					},
+				},
+				Position: position(1),
+			}},
+		},
+		{
+			AddChart: &sheets.AddChartRequest{Chart: &sheets.EmbeddedChart{
+				Spec: &sheets.ChartSpec{
+					Title: "일자별 Focus 합계 / 효율",
+					BasicChart: &sheets.BasicChartSpec{
+						ChartType:      "LINE",
+						LegendPosition: "BOTTOM_LEGEND",
+						HeaderCount:    1,
+						Axis: []*sheets.BasicChartAxis{
+							{Position: "BOTTOM_AXIS", Title: "날짜"},
+							{Position: "LEFT_AXIS", Title: "Focus 합계"},
+							{Position: "RIGHT_AXIS", Title: "효율"},
+						},
+						Domains: domain,
+						Series: []*sheets.BasicChartSeries{
+							{Series: data(cols - 2), TargetAxis: "LEFT_AXIS"},
+							{Series: data(cols - 1), TargetAxis: "RIGHT_AXIS"},
+						},
+					},
+				},
+				Position: position(20),
+			}},
+		},
+	}
+}
+
+// AddSummarySheet: 연간 스프레드시트에 "요약" 탭(일자별 카테고리 분, Focus 합계, 효율 수식 + 차트)을 맨 앞에 생성
+// - 이미 있으면 지우고 현재 카테고리 정의로 다시 만듦 (요약 탭은 수식만 있으므로 입력 데이터는 그대로)
+// - sheetsSrv: Google Sheets API 서비스
+// - spreadsheetID: 스프레드시트 ID
+// - year: 연도
+// - layout: 스프레드시트의 슬롯 구성
+// 반환: 에러 (없으면 nil)
+func AddSummarySheet(sheetsSrv *sheets.Service, spreadsheetID string, year int, layout common.SlotLayout) error {
+	rows := summaryRows(year, layout)
+	cols := len(rows[0])
+	return writeGeneratedSheet(sheetsSrv, spreadsheetID, SummarySheetTitle, "", rows, cols+summaryChartWidth, func(sheetID int64) []*sheets.Request {
+		return append(summaryFormatRequests(sheetID, cols, len(rows)), summaryChartRequests(sheetID, cols, len(rows))...)
+	})
+}
+
+// writeGeneratedSheet: 생성 전용 탭(요약, 분석)을 지우고 새로 만들어 값과 서식/차트를 씀
+// - title: 탭 이름 (이미 있으면 같은 위치에 다시 만듦)
+// - after: 새로 만들 때 이 탭 바로 뒤에 둠 (비었거나 없으면 맨 앞)
+// - rows: A1부터 쓸 값 (USER_ENTERED: 수식/날짜로 해석)
+// - colCount: 탭 열 수 (차트/이미지 자리 포함)
+// - format: 새 탭 ID로 서식/차트 요청 구성
+// 반환: 에러 (없으면 nil)
+func writeGeneratedSheet(sheetsSrv *sheets.Service, spreadsheetID, title, after string, rows [][]interface{}, colCount int, format func(sheetID int64) []*sheets.Request) error {
+	ss, err := sheetsSrv.Spreadsheets.Get(spreadsheetID).Fields("sheets(properties(sheetId,title,index))").Do()
+	if err != nil {
+		return fmt.Errorf("스프레드시트 조회 실패: %w", err)
+	}
+
+	// 1. 기존 탭 삭제 후 새 탭 추가
+	requests, index := []*sheets.Request{}, int64(-1)
+	for _, s := range ss.Sheets {
+		if s.Properties == nil {
+			continue
+		}
+		switch s.Properties.Title {
+		case title:
+			requests = append(requests, &sheets.Request{DeleteSheet: &sheets.DeleteSheetRequest{SheetId: s.Properties.SheetId}})
+			index = s.Properties.Index
+		case after:
+			if index < 0 && after != "" {
+				index = s.Properties.Index + 1
+			}
+		}
+	}
+	if index < 0 {
+		index = 0
+	}
+	requests = append(requests, &sheets.Request{
+		AddSheet: &sheets.AddSheetRequest{Properties: &sheets.SheetProperties{
+			Title:           title,
+			Index:           index,
+			ForceSendFields: []string{"Index"},
+			GridProperties:  &sheets.GridProperties{RowCount: int64(len(rows)), ColumnCount: int64(colCount)},
+		}},
+	})
+	resp, err := sheetsSrv.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{Requests: requests}).Do()
+	if err != nil {
+		return fmt.Errorf("%s 탭 생성 실패: %w", title, err)
+	}
+	var sheetID int64
+	for _, r := range resp.Replies {
+		if r.AddSheet != nil {
+			sheetID = r.AddSheet.Properties.SheetId
+		}
+	}
+
+	// 2. 값/수식 쓰기
+	vr := &sheets.ValueRange{Range: fmt.Sprintf("'%s'!A1", title), Values: rows}
+	if _, err := sheetsSrv.Spreadsheets.Values.Update(spreadsheetID, vr.Range, vr).ValueInputOption("USER_ENTERED").Do(); err != nil {
+		return fmt.Errorf("%s 탭 값 쓰기 실패: %w", title, err)
+	}
+
+	// 3. 서식 + 차트
+	if requests := format(sheetID); len(requests) > 0 {
+		if _, err := sheetsSrv.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{Requests: requests}).Do(); err != nil {
+			return fmt.Errorf("%s 탭 서식/차트 적용 실패: %w", title, err)
+		}
+	}
+	return nil
+}
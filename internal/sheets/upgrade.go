@@ -6,14 +6,28 @@ import (
 	"google.golang.org/api/sheets/v4"
 )
 
-// UpgradeSheetToNewFormatFrom: 지정한 월/일(startMonth, startDay)부터 연말까지 시트 포맷 업그레이드
+// UpgradeSheetToNewFormatFrom: 지정한 월/일(startMonth, startDay)부터 연말까지 시트 포맷 업그레이드 + 요약 탭 추가
 // - sheetsSrv: Google Sheets API 서비스
 // - spreadsheetID: 스프레드시트 ID
 // - year: 연도
 // - startMonth: 시작 월
 // - startDay: 시작 일
+// - 슬롯 구성은 스프레드시트에 기록된 값을 유지 (기록이 없는 이전 시트는 기본 10분으로 기록)
 // 반환: 에러 (없으면 nil)
 func UpgradeSheetToNewFormatFrom(sheetsSrv *sheets.Service, spreadsheetID string, year, startMonth, startDay int) error {
+	ss, err := sheetsSrv.Spreadsheets.Get(spreadsheetID).Fields("developerMetadata").Do()
+	if err != nil {
+		return fmt.Errorf("슬롯 구성 조회 실패: %w", err)
+	}
+	layout, recorded, err := slotLayoutOf(ss)
+	if err != nil {
+		return err
+	}
+	if !recorded {
+		if err := writeSlotLayout(sheetsSrv, spreadsheetID, layout); err != nil {
+			return err
+		}
+	}
 	sheetTitles := []string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"}
 	for monthIdx, name := range sheetTitles {
 		month := monthIdx + 1
@@ -21,14 +35,18 @@ func UpgradeSheetToNewFormatFrom(sheetsSrv *sheets.Service, spreadsheetID string
 			continue // 시작 월 이전은 건너뜀
 		}
 		// 1. 월별 시트 데이터 재초기화 (기존 데이터는 덮어씀)
-		if err := initSheetDataFrom(sheetsSrv, spreadsheetID, name, year, month, startDay, month == startMonth); err != nil {
+		if err := initSheetDataFrom(sheetsSrv, spreadsheetID, name, year, month, startDay, month == startMonth, layout); err != nil {
 			return fmt.Errorf("%s 시트 데이터 초기화 실패: %v", name, err)
 		}
 		// 2. 월별 시트 스타일/유효성/조건부서식 재적용
-		if err := applySheetStyles(sheetsSrv, spreadsheetID, name); err != nil {
+		if err := applySheetStyles(sheetsSrv, spreadsheetID, name, layout); err != nil {
 			return fmt.Errorf("%s 시트 스타일/유효성/조건부서식 적용 실패: %v", name, err)
 		}
 	}
+	// 3. 요약 탭 추가 (이미 있으면 현재 카테고리로 다시 생성)
+	if err := AddSummarySheet(sheetsSrv, spreadsheetID, year, layout); err != nil {
+		return err
+	}
 	return nil
 }
  
\ No newline at end of file
@@ -0,0 +1,193 @@
+package site
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"image/color"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/analyzer"
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+//go:embed dashboard.html.tmpl
+var dashboardTemplate string
+
+// FileName: GitBook assets 디렉토리에 생성되는 대시보드 파일 이름
+const FileName = "focus-dashboard.html"
+
+// Category: 대시보드 범례/선 색상용 카테고리 정보
+// - Name: 데이터 키 (한국어 카테고리 ID), Label: 화면에 표시할 이름
+type Category struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Color string `json:"color"` // CSS hex (예: "#f4cccc")
+}
+
+// Day: 하루치 대시보드 데이터
+// - Efficiency: 카테고리별 효율(%) = Categories / MaxScore * 100 (기록 없는 카테고리는 제외)
+// - Hourly: 시간(0~23)별 평균 몰입 점수 (0점 제외, 기록이 없으면 null)
+type Day struct {
+	Date       string             `json:"date"`
+	TotalFocus int                `json:"totalFocus"`
+	Efficiency map[string]float64 `json:"efficiency"`
+	Hourly     []*float64         `json:"hourly"`
+}
+
+// Text: 페이지/차트에 표시되는 문구 (BuildBundle에서 언어별로 채움)
+type Text struct {
+	Title         string `json:"title"`
+	Meta          string `json:"meta"`
+	Months        string `json:"months"` // fmt 형식 (예: "%d개월"), 기간 버튼용
+	All           string `json:"all"`
+	Days          string `json:"days"`
+	Slopes        string `json:"slopes"`
+	NoData        string `json:"noData"`
+	Regression    string `json:"regression"`
+	TrendTitle    string `json:"trendTitle"`
+	TimeSlotTitle string `json:"timeSlotTitle"`
+	AvgScore      string `json:"avgScore"`
+	Hour          string `json:"hour"`
+}
+
+// Bundle: HTML에 JSON으로 임베드되는 데이터 묶음
+type Bundle struct {
+	Lang        string     `json:"lang"`
+	GeneratedAt string     `json:"generatedAt"`
+	Text        Text       `json:"text"`
+	Categories  []Category `json:"categories"`
+	Days        []Day      `json:"days"`
+}
+
+// BuildBundle: FocusData 배열 → 대시보드 데이터 묶음 (일자 오름차순)
+// - data: 여러 일자의 FocusData 배열
+// - now: 생성 시각
+// - tr: 페이지 문구/카테고리 표시 언어
+// 카테고리 색상은 페이지 배경에 맞춰 LightTheme의 선 색상을 사용
+func BuildBundle(data []common.FocusData, now time.Time, tr i18n.Printer) Bundle {
+	theme := analyzer.LightTheme()
+	sorted := make([]common.FocusData, len(data))
+	copy(sorted, data)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Date < sorted[j].Date })
+
+	seen := map[string]bool{}
+	days := make([]Day, 0, len(sorted))
+	for _, d := range sorted {
+		day := Day{Date: d.Date, TotalFocus: d.TotalFocus, Efficiency: map[string]float64{}, Hourly: hourlyAverages(d)}
+		for cat, v := range d.Categories {
+			max := d.MaxScore[cat]
+			if max <= 0 {
+				continue
+			}
+			day.Efficiency[cat] = float64(v) / float64(max) * 100.0
+			seen[cat] = true
+		}
+		days = append(days, day)
+	}
+
+	cats := []Category{}
+	for _, cat := range common.Categories {
+		if seen[cat] {
+			cats = append(cats, Category{Name: cat, Label: tr.Category(cat), Color: hexColor(theme.CategoryLineColor(cat))})
+			delete(seen, cat)
+		}
+	}
+	extra := make([]string, 0, len(seen))
+	for cat := range seen {
+		extra = append(extra, cat)
+	}
+	sort.Strings(extra)
+	for _, cat := range extra {
+		cats = append(cats, Category{Name: cat, Label: tr.Category(cat), Color: hexColor(theme.CategoryLineColor(cat))})
+	}
+
+	generatedAt := now.Format("2006-01-02 15:04")
+	text := Text{
+		Title:         tr.T("site.title"),
+		Meta:          tr.T("site.meta", generatedAt, len(days)),
+		Months:        tr.T("site.months"),
+		All:           tr.T("site.all"),
+		Days:          tr.T("site.days"),
+		Slopes:        tr.T("site.slopes"),
+		NoData:        tr.T("site.noData"),
+		Regression:    tr.T("site.reg"),
+		TrendTitle:    tr.T("site.trendTitle"),
+		TimeSlotTitle: tr.T("site.timeSlotTitle"),
+		AvgScore:      tr.T("axis.avgScore"),
+		Hour:          tr.T("site.hour"),
+	}
+	return Bundle{Lang: string(tr.Locale()), GeneratedAt: generatedAt, Text: text, Categories: cats, Days: days}
+}
+
+// hourlyAverages: 하루의 10분 단위 점수를 시간별 평균으로 묶기 (0점 제외)
+func hourlyAverages(d common.FocusData) []*float64 {
+	sum := make([]float64, 24)
+	count := make([]float64, 24)
+	for key, v := range d.TimeSlots {
+		if v == 0 {
+			continue
+		}
+		var h, m int
+		if _, err := fmt.Sscanf(key, "%02d:%02d", &h, &m); err != nil || h < 0 || h > 23 {
+			continue
+		}
+		sum[h] += float64(v)
+		count[h]++
+	}
+	out := make([]*float64, 24)
+	for h := range out {
+		if count[h] > 0 {
+			avg := sum[h] / count[h]
+			out[h] = &avg
+		}
+	}
+	return out
+}
+
+// hexColor: color.Color → CSS hex 문자열
+func hexColor(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
+
+// Render: 데이터 묶음을 임베드한 단일 HTML 페이지 생성
+// 반환: HTML []byte, 에러
+func Render(b Bundle) ([]byte, error) {
+	tmpl, err := template.New("dashboard").Parse(dashboardTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("대시보드 템플릿 파싱 실패: %w", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, b); err != nil {
+		return nil, fmt.Errorf("대시보드 렌더링 실패: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Generate: FocusData 배열로 대시보드 HTML을 만들어 outPath에 저장
+// - data: 대시보드에 넣을 FocusData 배열
+// - outPath: 저장 경로 (디렉토리가 없으면 생성)
+// - now: 생성 시각
+// - tr: 페이지 문구/카테고리 표시 언어
+func Generate(data []common.FocusData, outPath string, now time.Time, tr i18n.Printer) error {
+	if len(data) == 0 {
+		return fmt.Errorf("대시보드에 넣을 데이터가 없습니다")
+	}
+	html, err := Render(BuildBundle(data, now, tr))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %w", err)
+	}
+	if err := os.WriteFile(outPath, html, 0644); err != nil {
+		return fmt.Errorf("대시보드 저장 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package site
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+func TestBuildBundle(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-02", Categories: map[string]int{"업무": 40}, MaxScore: map[string]int{"업무": 80}, TimeSlots: map[string]int{"09:00": 50, "09:10": 70, "10:00": 0}},
+		{Date: "2025-05-01", Categories: map[string]int{"학습": 30, "없음": 10}, MaxScore: map[string]int{"학습": 60}},
+	}
+	b := BuildBundle(data, time.Date(2025, 5, 3, 8, 0, 0, 0, time.UTC), i18n.New(i18n.Korean))
+	if len(b.Days) != 2 || b.Days[0].Date != "2025-05-01" {
+		t.Fatalf("일자 정렬 이상: %+v", b.Days)
+	}
+	if got := b.Days[1].Efficiency["업무"]; got != 50 {
+		t.Errorf("업무 효율 = %v, want 50", got)
+	}
+	if _, ok := b.Days[0].Efficiency["없음"]; ok {
+		t.Errorf("MaxScore 없는 카테고리가 포함됨")
+	}
+	if h := b.Days[1].Hourly[9]; h == nil || *h != 60 {
+		t.Errorf("09시 평균 이상: %v", h)
+	}
+	if b.Days[1].Hourly[10] != nil {
+		t.Errorf("0점만 있는 시간은 null이어야 함")
+	}
+	if len(b.Categories) != 2 || b.Categories[0].Name != "업무" || !strings.HasPrefix(b.Categories[0].Color, "#") {
+		t.Errorf("카테고리 목록 이상: %+v", b.Categories)
+	}
+	if b.Lang != "ko" || b.Text.Meta != "생성: 2025-05-03 08:00 · 기록 2일" {
+		t.Errorf("문구 이상: %s %+v", b.Lang, b.Text)
+	}
+
+	en := BuildBundle(data, time.Date(2025, 5, 3, 8, 0, 0, 0, time.UTC), i18n.New(i18n.English))
+	if en.Lang != "en" || en.Categories[0].Name != "업무" || en.Categories[0].Label != "Work" || en.Text.Title != "Focus dashboard" {
+		t.Errorf("영어 묶음 이상: %s %+v %+v", en.Lang, en.Categories, en.Text)
+	}
+}
+
+func TestRender_EmbedsJSON(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", Categories: map[string]int{"</script>": 10}, MaxScore: map[string]int{"</script>": 20}},
+	}
+	html, err := Render(BuildBundle(data, time.Now(), i18n.New(i18n.Korean)))
+	if err != nil {
+		t.Fatalf("Render 실패: %v", err)
+	}
+	s := string(html)
+	if strings.Count(s, "</script>") != 2 {
+		t.Errorf("카테고리 이름이 이스케이프되지 않음")
+	}
+	m := regexp.MustCompile(`const bundle = (.*);\n`).FindStringSubmatch(s)
+	if m == nil {
+		t.Fatalf("임베드된 데이터가 없음")
+	}
+	var b Bundle
+	if err := json.Unmarshal([]byte(m[1]), &b); err != nil {
+		t.Fatalf("임베드된 JSON 파싱 실패: %v", err)
+	}
+	if len(b.Days) != 1 || b.Days[0].Efficiency["</script>"] != 50 {
+		t.Errorf("임베드된 데이터 이상: %+v", b)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "assets", FileName)
+	if err := Generate(nil, out, time.Now(), i18n.New(i18n.Korean)); err == nil {
+		t.Errorf("빈 데이터에 에러가 없음")
+	}
+	data := []common.FocusData{{Date: "2025-05-01", Categories: map[string]int{"업무": 10}, MaxScore: map[string]int{"업무": 20}}}
+	if err := Generate(data, out, time.Now(), i18n.New(i18n.English)); err != nil {
+		t.Fatalf("Generate 실패: %v", err)
+	}
+	html, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("생성된 파일 읽기 실패: %v", err)
+	}
+	if !strings.Contains(string(html), `<html lang="en">`) || !strings.Contains(string(html), "<h1>Focus dashboard</h1>") {
+		t.Errorf("영어 페이지가 아님")
+	}
+}
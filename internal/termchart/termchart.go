@@ -0,0 +1,240 @@
+// Package termchart: 터미널에서 바로 볼 수 있는 유니코드 스파크라인/막대/타임라인/표
+package termchart
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+// sparkRunes: 낮음→높음 8단계 블록
+var sparkRunes = []rune("▁▂▃▄▅▆▇█")
+
+// barRunes: 한 칸을 1/8 단위로 나눈 부분 블록 (빈칸 ~ 가득)
+var barRunes = []rune(" ▏▎▍▌▋▊▉█")
+
+// Sparkline: 값 배열 → 한 줄 스파크라인 (NaN은 공백, min==max면 가운데 높이)
+// - values: 값 배열
+// - min, max: 값 범위 (범위 밖 값은 잘림)
+func Sparkline(values []float64, min, max float64) string {
+	var b strings.Builder
+	for _, v := range values {
+		if math.IsNaN(v) {
+			b.WriteRune(' ')
+			continue
+		}
+		idx := len(sparkRunes) / 2
+		if max > min {
+			ratio := (v - min) / (max - min)
+			idx = int(math.Round(clamp01(ratio) * float64(len(sparkRunes)-1)))
+		}
+		b.WriteRune(sparkRunes[idx])
+	}
+	return b.String()
+}
+
+// Bar: 값 → 가로 막대 (width칸 = max, 1/8칸 단위, 항상 width칸 너비로 채움)
+func Bar(value, max float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	ratio := 0.0
+	if max > 0 {
+		ratio = clamp01(value / max)
+	}
+	eighths := int(math.Round(ratio * float64(width*8)))
+	full, rest := eighths/8, eighths%8
+	var b strings.Builder
+	b.WriteString(strings.Repeat("█", full))
+	if full < width {
+		b.WriteRune(barRunes[rest])
+		b.WriteString(strings.Repeat(" ", width-full-1))
+	}
+	return b.String()
+}
+
+// Timeline: 하루 24시간 타임라인 (시간당 2칸)
+// - 1줄: 시간 눈금, 2줄: 시간별 주 카테고리 표시 이름 첫 글자 (slotLabels 없으면 ·), 3줄: 시간별 평균 점수 블록
+// - tr: 카테고리 표시 언어
+// 반환: 줄바꿈으로 구분된 3줄 문자열
+func Timeline(d common.FocusData, tr i18n.Printer) string {
+	var ruler, cats, scores strings.Builder
+	for h := 0; h < 24; h++ {
+		if h%3 == 0 {
+			ruler.WriteString(fmt.Sprintf("%-6d", h))
+		}
+		sum, count := 0.0, 0.0
+		catCount := map[string]int{}
+		best := ""
+		for m := 0; m < 60; m += common.SlotMinutesOf(d) {
+			key := fmt.Sprintf("%02d:%02d", h, m)
+			if label := d.SlotLabels[key]; label != "" {
+				catCount[label]++
+				if best == "" || catCount[label] > catCount[best] {
+					best = label
+				}
+			}
+			if v, ok := d.TimeSlots[key]; ok && v > 0 {
+				sum += float64(v)
+				count++
+			}
+		}
+		if best != "" {
+			best = tr.Category(best)
+		}
+		cats.WriteString(initial(best))
+		if count == 0 {
+			scores.WriteString("  ")
+			continue
+		}
+		r := Sparkline([]float64{sum / count}, 0, common.MaxSlotScore)
+		scores.WriteString(r + r)
+	}
+	return strings.TrimRight(ruler.String(), " ") + "\n" + cats.String() + "\n" + scores.String()
+}
+
+// initial: 카테고리 이름 첫 글자를 2칸 너비로 (없으면 ·)
+func initial(cat string) string {
+	for _, r := range cat {
+		if runeWidth(r) == 2 {
+			return string(r)
+		}
+		return string(r) + " "
+	}
+	return "· "
+}
+
+// Row: 표 한 줄 (카테고리별 요약)
+type Row struct {
+	Category   string
+	Hours      float64 // 기록된 시간 (슬롯 수 × 10분)
+	Focus      int     // 점수 합
+	Efficiency float64 // 효율(%) = 점수 합 / 최대 점수 * 100
+	Trend      []float64
+}
+
+// Rows: 여러 일자 데이터 → 카테고리별 요약 (기록 없는 카테고리 제외, common.Categories 순서 + 나머지)
+func Rows(data []common.FocusData) []Row {
+	order := append([]string{}, common.Categories...)
+	known := map[string]bool{}
+	for _, c := range order {
+		known[c] = true
+	}
+	for _, d := range data {
+		for c := range d.MaxScore {
+			if !known[c] {
+				known[c] = true
+				order = append(order, c)
+			}
+		}
+	}
+	rows := []Row{}
+	for _, cat := range order {
+		row := Row{Category: cat, Trend: make([]float64, len(data))}
+		max := 0
+		for i, d := range data {
+			row.Trend[i] = math.NaN()
+			m := d.MaxScore[cat]
+			if m <= 0 {
+				continue
+			}
+			max += m
+			row.Focus += d.Categories[cat]
+			row.Hours += float64(m) / common.MaxSlotScore * float64(common.SlotMinutesOf(d)) / 60.0
+			row.Trend[i] = float64(d.Categories[cat]) / float64(m) * 100.0
+		}
+		if max == 0 {
+			continue
+		}
+		row.Efficiency = float64(row.Focus) / float64(max) * 100.0
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// Render: 기간 요약(카테고리별 스파크라인/막대, 마지막 날 타임라인, 합계 표)을 w에 출력
+// - data: 일자 오름차순 FocusData 배열
+// - tr: 표 머리글/카테고리/날짜 언어
+func Render(w io.Writer, data []common.FocusData, tr i18n.Printer) error {
+	if len(data) == 0 {
+		return fmt.Errorf("표시할 데이터가 없습니다")
+	}
+	rows := Rows(data)
+	nameWidth := displayWidth(tr.T("term.category"))
+	for _, r := range rows {
+		if dw := displayWidth(tr.Category(r.Category)); dw > nameWidth {
+			nameWidth = dw
+		}
+	}
+
+	fmt.Fprintf(w, "%s\n\n", tr.T("term.header", tr.DateRange(data[0].Date, data[len(data)-1].Date), len(data)))
+	sparkWidth := len(data)
+	if dw := displayWidth(tr.T("term.daily")); dw > sparkWidth {
+		sparkWidth = dw
+	}
+	fmt.Fprintf(w, "%s  %s  %s\n", padRight(tr.T("term.category"), nameWidth), padRight(tr.T("term.daily"), sparkWidth), tr.T("term.avgEff"))
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s  %s  %s %5.1f%%\n", padRight(tr.Category(r.Category), nameWidth), padRight(Sparkline(r.Trend, 0, 100), sparkWidth), Bar(r.Efficiency, 100, 20), r.Efficiency)
+	}
+
+	last := data[len(data)-1]
+	lastDate := last.Date
+	if t, err := time.Parse("2006-01-02", last.Date); err == nil {
+		lastDate = tr.Date(t)
+	}
+	fmt.Fprintf(w, "\n%s\n%s\n", tr.T("term.timeline", lastDate), Timeline(last, tr))
+
+	fmt.Fprintf(w, "\n%s  %s  %s  %s\n", padRight(tr.T("term.category"), nameWidth), padLeft(tr.T("term.hours"), 8), padLeft(tr.T("term.focus"), 8), padLeft(tr.T("term.eff"), 8))
+	totalHours, totalFocus := 0.0, 0
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s  %8.1f  %8d  %7.1f%%\n", padRight(tr.Category(r.Category), nameWidth), r.Hours, r.Focus, r.Efficiency)
+		totalHours += r.Hours
+		totalFocus += r.Focus
+	}
+	_, err := fmt.Fprintf(w, "%s  %8.1f  %8d\n", padRight(tr.T("term.total"), nameWidth), totalHours, totalFocus)
+	return err
+}
+
+// displayWidth: 터미널 표시 너비 (한글/전각 2칸)
+func displayWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+// runeWidth: 한 글자의 터미널 표시 너비
+func runeWidth(r rune) int {
+	if unicode.Is(unicode.Hangul, r) || unicode.Is(unicode.Han, r) || (r >= 0xFF01 && r <= 0xFF60) {
+		return 2
+	}
+	return 1
+}
+
+// padRight: 표시 너비 기준으로 오른쪽을 공백으로 채움
+func padRight(s string, width int) string {
+	if dw := displayWidth(s); dw < width {
+		return s + strings.Repeat(" ", width-dw)
+	}
+	return s
+}
+
+// padLeft: 표시 너비 기준으로 왼쪽을 공백으로 채움
+func padLeft(s string, width int) string {
+	if dw := displayWidth(s); dw < width {
+		return strings.Repeat(" ", width-dw) + s
+	}
+	return s
+}
+
+// clamp01: 0~1 범위로 자르기
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
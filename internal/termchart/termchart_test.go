@@ -0,0 +1,103 @@
+package termchart
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/crispy/focus-time-tracker/internal/common"
+	"github.com/crispy/focus-time-tracker/internal/i18n"
+)
+
+func TestSparkline(t *testing.T) {
+	if got := Sparkline([]float64{0, 50, 100, math.NaN()}, 0, 100); got != "▁▅█ " {
+		t.Errorf("Sparkline = %q", got)
+	}
+	if got := Sparkline([]float64{3, 3}, 3, 3); got != "▅▅" {
+		t.Errorf("같은 값 Sparkline = %q", got)
+	}
+}
+
+func TestBar(t *testing.T) {
+	if got := Bar(50, 100, 4); got != "██  " {
+		t.Errorf("Bar(50) = %q", got)
+	}
+	if got := Bar(100, 100, 4); got != "████" {
+		t.Errorf("Bar(100) = %q", got)
+	}
+	if got := Bar(1, 8, 1); got != "▏" {
+		t.Errorf("Bar(1/8) = %q", got)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	d := common.FocusData{
+		TimeSlots:  map[string]int{"09:00": 5, "09:10": 3, "10:00": 0},
+		SlotLabels: map[string]string{"09:00": "업무", "09:10": "업무", "09:20": "학습"},
+	}
+	lines := strings.Split(Timeline(d, i18n.New(i18n.Korean)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Timeline 줄 수 = %d, want 3", len(lines))
+	}
+	cats := []rune(lines[1])
+	// 시간당 2칸: 한글 첫 글자는 1글자(2칸), 빈 시간은 "· "(2글자)
+	if !strings.Contains(lines[1], "업") || cats[0] != '·' {
+		t.Errorf("카테고리 줄 이상: %q", lines[1])
+	}
+	if displayWidth(lines[1]) != 48 || displayWidth(lines[2]) != 48 {
+		t.Errorf("타임라인 너비 이상: %d, %d", displayWidth(lines[1]), displayWidth(lines[2]))
+	}
+	// 영어: 표시 이름(Work) 첫 글자 + 공백
+	if en := strings.Split(Timeline(d, i18n.New(i18n.English)), "\n")[1]; !strings.Contains(en, "W ") || displayWidth(en) != 48 {
+		t.Errorf("영어 카테고리 줄 이상: %q", en)
+	}
+}
+
+func TestSlotMinutes(t *testing.T) {
+	// 30분 슬롯: 09:30 칸만 있어도 9시 칸에 표시, 업무 2칸(MaxScore 10) = 1시간
+	d := common.FocusData{
+		SlotMinutes: 30,
+		TimeSlots:   map[string]int{"09:30": 5},
+		SlotLabels:  map[string]string{"09:30": "업무"},
+		Categories:  map[string]int{"업무": 8},
+		MaxScore:    map[string]int{"업무": 10},
+	}
+	if cats := strings.Split(Timeline(d, i18n.New(i18n.Korean)), "\n")[1]; !strings.Contains(cats, "업") {
+		t.Errorf("30분 슬롯 카테고리 줄 이상: %q", cats)
+	}
+	if rows := Rows([]common.FocusData{d}); len(rows) != 1 || rows[0].Hours != 1 {
+		t.Errorf("30분 슬롯 시간 이상: %+v", rows)
+	}
+}
+
+func TestRender(t *testing.T) {
+	data := []common.FocusData{
+		{Date: "2025-05-01", Categories: map[string]int{"업무": 10}, MaxScore: map[string]int{"업무": 20, "학습": 0}},
+		{Date: "2025-05-02", Categories: map[string]int{"업무": 20, "새일": 5}, MaxScore: map[string]int{"업무": 20, "새일": 10}},
+	}
+	rows := Rows(data)
+	if len(rows) != 2 || rows[0].Category != "업무" || rows[1].Category != "새일" {
+		t.Fatalf("Rows 이상: %+v", rows)
+	}
+	if rows[0].Efficiency != 75 || rows[0].Focus != 30 {
+		t.Errorf("업무 요약 이상: %+v", rows[0])
+	}
+	var buf bytes.Buffer
+	if err := Render(&buf, data, i18n.New(i18n.Korean)); err != nil {
+		t.Fatalf("Render 실패: %v", err)
+	}
+	if !strings.Contains(buf.String(), "2025년 5월 1일 ~ 5월 2일 (2일)") || !strings.Contains(buf.String(), "75.0%") {
+		t.Errorf("출력 이상:\n%s", buf.String())
+	}
+	buf.Reset()
+	if err := Render(&buf, data, i18n.New(i18n.English)); err != nil {
+		t.Fatalf("영어 Render 실패: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "May 1 - May 2, 2025 (2 days)") || !strings.Contains(out, "Work") || !strings.Contains(out, "새일") {
+		t.Errorf("영어 출력 이상:\n%s", out)
+	}
+	if err := Render(&buf, nil, i18n.New(i18n.Korean)); err == nil {
+		t.Errorf("빈 데이터에 에러가 없음")
+	}
+}